@@ -0,0 +1,58 @@
+package main
+
+import (
+	"context"
+	"flag"
+	"fmt"
+	"log/slog"
+	"os"
+
+	"github.com/wandb/wandb/core/pkg/observability"
+	"github.com/wandb/wandb/core/pkg/rundiff"
+)
+
+// diffMain implements the diff subcommand, which compares the transaction
+// logs of two runs.
+//
+//	wandb-core diff [-json] LEFT.wandb RIGHT.wandb
+//
+// Like diff(1), it exits with 1 if the logs differ.
+func diffMain(args []string) int {
+	flags := flag.NewFlagSet("diff", flag.ContinueOnError)
+	asJSON := flags.Bool("json", false, "print the differences as JSON")
+	flags.Usage = func() {
+		fmt.Fprintln(flags.Output(), "usage: wandb-core diff [-json] LEFT.wandb RIGHT.wandb")
+		flags.PrintDefaults()
+	}
+	if err := flags.Parse(args); err != nil {
+		return 2
+	}
+	if flags.NArg() != 2 {
+		flags.Usage()
+		return 2
+	}
+
+	logger := observability.NewCoreLogger(
+		slog.New(slog.NewTextHandler(os.Stderr, &slog.HandlerOptions{Level: slog.LevelWarn})),
+	)
+	result, err := rundiff.Diff(context.Background(), logger, flags.Arg(0), flags.Arg(1))
+	if err != nil {
+		fmt.Fprintf(os.Stderr, "diff failed: %v\n", err)
+		return 2
+	}
+
+	if *asJSON {
+		err = result.WriteJSON(os.Stdout)
+	} else {
+		err = result.WriteText(os.Stdout)
+	}
+	if err != nil {
+		fmt.Fprintf(os.Stderr, "diff failed: %v\n", err)
+		return 2
+	}
+
+	if len(result.Differences) > 0 {
+		return 1
+	}
+	return 0
+}
@@ -0,0 +1,57 @@
+package main
+
+import (
+	"context"
+	"flag"
+	"fmt"
+	"log/slog"
+	"os"
+	"strings"
+
+	"github.com/wandb/wandb/core/pkg/export"
+	"github.com/wandb/wandb/core/pkg/observability"
+)
+
+// exportMain implements the export subcommand, which converts transaction
+// logs into plain files.
+//
+//	wandb-core export [-out DIR] run-ID.wandb
+func exportMain(args []string) int {
+	flags := flag.NewFlagSet("export", flag.ContinueOnError)
+	out := flags.String("out", "", "directory to write the files to (default: the log's name with an -export suffix)")
+	flags.Usage = func() {
+		fmt.Fprintln(flags.Output(), "usage: wandb-core export [-out DIR] FILE.wandb")
+		flags.PrintDefaults()
+	}
+	if err := flags.Parse(args); err != nil {
+		return 2
+	}
+	if flags.NArg() != 1 {
+		flags.Usage()
+		return 2
+	}
+
+	syncFile := flags.Arg(0)
+	dir := *out
+	if dir == "" {
+		dir = strings.TrimSuffix(syncFile, ".wandb") + "-export"
+	}
+
+	logger := observability.NewCoreLogger(
+		slog.New(slog.NewTextHandler(os.Stderr, nil)),
+	)
+	stats, err := export.Export(context.Background(), logger, syncFile, dir)
+	if err != nil {
+		fmt.Fprintf(os.Stderr, "export failed: %v\n", err)
+		return 1
+	}
+
+	fmt.Printf(
+		"exported %d records to %s: %d history rows, %d system metrics samples, %d output lines, %d artifacts\n",
+		stats.Records, dir, stats.History, stats.SystemMetrics, stats.Output, stats.Artifacts,
+	)
+	if stats.Corrupt > 0 {
+		fmt.Printf("skipped %d corrupt records\n", stats.Corrupt)
+	}
+	return 0
+}
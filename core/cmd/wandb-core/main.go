@@ -22,6 +22,17 @@ func init() {
 }
 
 func main() {
+	if len(os.Args) > 1 {
+		switch os.Args[1] {
+		case "diff":
+			os.Exit(diffMain(os.Args[2:]))
+		case "export":
+			os.Exit(exportMain(os.Args[2:]))
+		case "sync":
+			os.Exit(syncMain(os.Args[2:]))
+		}
+	}
+
 	// Flags to control the server
 	portFilename := flag.String("port-filename", "port_file.txt", "filename for port to communicate with client")
 	pid := flag.Int("pid", 0, "pid of the process to communicate with")
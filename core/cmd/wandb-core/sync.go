@@ -0,0 +1,235 @@
+package main
+
+import (
+	"context"
+	"flag"
+	"fmt"
+	"io/fs"
+	"log/slog"
+	"os"
+	"path/filepath"
+	"strings"
+
+	"google.golang.org/protobuf/types/known/wrapperspb"
+
+	"github.com/wandb/wandb/core/internal/settings"
+	gowandbsettings "github.com/wandb/wandb/core/pkg/gowandb/settings"
+	"github.com/wandb/wandb/core/pkg/observability"
+	"github.com/wandb/wandb/core/pkg/server"
+	"github.com/wandb/wandb/core/pkg/service"
+)
+
+// syncMain implements the sync subcommand, which uploads many offline runs
+// at once.
+//
+//	wandb-core sync [flags] PATH...
+//
+// Every PATH is a transaction log or a directory that is searched for them.
+// A run whose upload stopped before it finished only has the rest of its
+// log uploaded. With -repair, a log that is corrupt or ends in a partly
+// written record is first copied without its unreadable parts, and the copy
+// is uploaded instead.
+func syncMain(args []string) int {
+	flags := flag.NewFlagSet("sync", flag.ContinueOnError)
+	parallel := flags.Int("parallel", 4, "number of runs to sync at the same time")
+	entity := flags.String("entity", "", "entity to upload the runs to (default: the runs' entity)")
+	project := flags.String("project", "", "project to upload the runs to (default: the runs' project)")
+	group := flags.String("group", "", "group to put the runs in")
+	jobType := flags.String("job-type", "", "job type to give the runs")
+	tags := flags.String("tags", "", "comma-separated tags to replace the runs' tags with")
+	skipRecords := flags.String("skip-records", "", `comma-separated record types to not upload, e.g. "stats,tbrecord"`)
+	follow := flags.Bool("follow", false, "keep syncing runs that are still being written until they finish")
+	skipHistory := flags.String("skip-history", "", "comma-separated glob patterns of history keys to not upload, in the history or the summary")
+	unfinished := flags.Bool("unfinished", false, "only sync runs whose upload stopped before it finished, such as runs of a process that crashed")
+	repair := flags.Bool("repair", false, "sync a copy of every corrupt or truncated log with only its valid records, written next to it with a .repaired suffix")
+	flags.Usage = func() {
+		fmt.Fprintln(flags.Output(), "usage: wandb-core sync [flags] PATH...")
+		flags.PrintDefaults()
+	}
+	if err := flags.Parse(args); err != nil {
+		return 2
+	}
+	if flags.NArg() == 0 {
+		flags.Usage()
+		return 2
+	}
+
+	var paths []string
+	var err error
+	if *unfinished {
+		paths, err = findUnfinishedSyncFiles(flags.Args())
+	} else {
+		paths, err = findSyncFiles(flags.Args())
+	}
+	if err != nil {
+		fmt.Fprintf(os.Stderr, "sync failed: %v\n", err)
+		return 1
+	}
+	if len(paths) == 0 {
+		fmt.Fprintln(os.Stderr, "sync: no .wandb files found")
+		return 1
+	}
+
+	logger := observability.NewCoreLogger(
+		slog.New(slog.NewTextHandler(os.Stderr, &slog.HandlerOptions{Level: slog.LevelWarn})),
+	)
+
+	if *repair {
+		paths, err = repairSyncFiles(context.Background(), logger, paths)
+		if err != nil {
+			fmt.Fprintf(os.Stderr, "sync failed: %v\n", err)
+			return 1
+		}
+	}
+
+	s := settings.From(gowandbsettings.NewSettings().Settings)
+	s.Proto.XOffline = &wrapperspb.BoolValue{Value: false}
+	if err := s.EnsureAPIKey(); err != nil {
+		fmt.Fprintf(os.Stderr, "sync failed: %v\n", err)
+		return 1
+	}
+
+	overwrite := &service.SyncOverwrite{
+		Entity:  *entity,
+		Project: *project,
+		Group:   *group,
+		JobType: *jobType,
+		Tags:    splitList(*tags),
+	}
+	skip := &service.SyncSkip{
+		RecordTypes: splitList(*skipRecords),
+		HistoryKeys: splitList(*skipHistory),
+	}
+
+	failed := 0
+	batch := server.NewBatchSync(server.BatchSyncParams{
+		Logger:      logger,
+		Settings:    s,
+		Paths:       paths,
+		Parallelism: *parallel,
+		Overwrite:   overwrite,
+		Skip:        skip,
+		Follow:      *follow,
+		OnProgress: func(progress server.BatchSyncProgress) {
+			prefix := fmt.Sprintf("[%d/%d] %s", progress.Done, progress.Total, progress.Path)
+			if err := progress.Response.GetError(); err != nil {
+				failed++
+				fmt.Printf("%s: failed: %s\n", prefix, err.GetMessage())
+			} else {
+				fmt.Printf("%s: %s\n", prefix, progress.Response.GetUrl())
+			}
+		},
+	})
+	batch.Run(context.Background())
+
+	if failed > 0 {
+		fmt.Fprintf(os.Stderr, "failed to sync %d of %d runs\n", failed, len(paths))
+		return 1
+	}
+	return 0
+}
+
+// findSyncFiles returns the transaction logs among paths and in the
+// directories among them.
+func findSyncFiles(paths []string) ([]string, error) {
+	var syncFiles []string
+	for _, path := range paths {
+		info, err := os.Stat(path)
+		if err != nil {
+			return nil, err
+		}
+		if !info.IsDir() {
+			syncFiles = append(syncFiles, path)
+			continue
+		}
+
+		err = filepath.WalkDir(path, func(path string, d fs.DirEntry, err error) error {
+			if err != nil {
+				return err
+			}
+			if !d.IsDir() && strings.HasSuffix(path, ".wandb") {
+				syncFiles = append(syncFiles, path)
+			}
+			return nil
+		})
+		if err != nil {
+			return nil, err
+		}
+	}
+	return syncFiles, nil
+}
+
+// findUnfinishedSyncFiles returns the transaction logs among paths and in
+// the run directories under the directories among them whose upload stopped
+// before it finished.
+func findUnfinishedSyncFiles(paths []string) ([]string, error) {
+	var syncFiles []string
+	for _, path := range paths {
+		info, err := os.Stat(path)
+		if err != nil {
+			return nil, err
+		}
+		if info.IsDir() {
+			logs, err := server.FindUnfinishedLogs(path)
+			if err != nil {
+				return nil, err
+			}
+			syncFiles = append(syncFiles, logs...)
+			continue
+		}
+
+		state, err := server.LoadCheckpoint(server.CheckpointPath(path))
+		if err == nil && !state.Finished {
+			syncFiles = append(syncFiles, path)
+		}
+	}
+	return syncFiles, nil
+}
+
+// repairSyncFiles returns the transaction logs to sync in place of paths.
+//
+// Every log that cannot be read in full is copied without its unreadable
+// parts to a file with a .repaired suffix, which takes its place.
+func repairSyncFiles(
+	ctx context.Context,
+	logger *observability.CoreLogger,
+	paths []string,
+) ([]string, error) {
+	repaired := make([]string, len(paths))
+	for i, path := range paths {
+		report, err := server.ScanStore(ctx, logger, path, nil)
+		if err != nil {
+			return nil, err
+		}
+		if report.Clean() {
+			repaired[i] = path
+			continue
+		}
+
+		dst := path + ".repaired"
+		report, err = server.RepairStore(ctx, logger, path, dst)
+		if err != nil {
+			return nil, err
+		}
+		fmt.Printf(
+			"%s: repaired into %s: kept %d records, skipped %d corrupt ranges, truncated: %t\n",
+			path, dst, report.Records, len(report.CorruptRanges), report.Truncated,
+		)
+		if !report.HasRun {
+			fmt.Printf("%s: warning: the run record was lost\n", path)
+		}
+		repaired[i] = dst
+	}
+	return repaired, nil
+}
+
+// splitList returns the items of a comma-separated list.
+func splitList(list string) []string {
+	var items []string
+	for _, item := range strings.Split(list, ",") {
+		if item = strings.TrimSpace(item); item != "" {
+			items = append(items, item)
+		}
+	}
+	return items
+}
@@ -160,6 +160,13 @@ type ClientOptions struct {
 	// on the request and response. Need to make sure that the response body is
 	// available to read by later stages.
 	NetworkPeeker Peeker
+
+	// Whether to send requests as soon as they are made.
+	//
+	// By default, the client slows down its requests as the server's
+	// RateLimit headers ask. Clients that limit their rate themselves, like
+	// the filestream, set this so that the limits do not stack.
+	DisableRateLimit bool
 }
 
 // Creates a new [Client] for making requests to the [Backend].
@@ -189,11 +196,12 @@ func (backend *Backend) NewClient(opts ClientOptions) Client {
 		)
 	}
 
+	transport := retryableHTTP.HTTPClient.Transport
+	if !opts.DisableRateLimit {
+		transport = NewRateLimitedTransport(transport)
+	}
 	retryableHTTP.HTTPClient.Transport =
-		NewPeekingTransport(
-			opts.NetworkPeeker,
-			NewRateLimitedTransport(retryableHTTP.HTTPClient.Transport),
-		)
+		NewPeekingTransport(opts.NetworkPeeker, transport)
 
 	return &clientImpl{
 		backend:       backend,
@@ -6,8 +6,10 @@ import (
 	"net/http"
 	"net/http/httptest"
 	"net/url"
+	"strconv"
 	"sync"
 	"testing"
+	"time"
 
 	"github.com/stretchr/testify/assert"
 	"github.com/wandb/wandb/core/internal/api"
@@ -51,6 +53,30 @@ func TestSend(t *testing.T) {
 	assert.Equal(t, "Basic YXBpOg==", req.Header.Get("Authorization"))
 }
 
+func TestDisableRateLimit(t *testing.T) {
+	// the server allows about one request per second
+	remaining := 10000
+	server := httptest.NewServer(http.HandlerFunc(
+		func(w http.ResponseWriter, r *http.Request) {
+			remaining -= 10
+			w.Header().Set("RateLimit-Remaining", strconv.Itoa(remaining))
+			w.Header().Set("RateLimit-Reset", "100")
+			_, _ = w.Write([]byte("OK"))
+		}),
+	)
+	defer server.Close()
+	client := apitest.TestingClient(server.URL, api.ClientOptions{
+		DisableRateLimit: true,
+	})
+
+	start := time.Now()
+	for i := 0; i < 100; i++ {
+		_, err := client.Send(&api.Request{Method: http.MethodGet, Path: "path"})
+		assert.NoError(t, err)
+	}
+	assert.Less(t, time.Since(start), 5*time.Second)
+}
+
 func TestDo_ToWandb_SetsAuth(t *testing.T) {
 	server := NewRecordingServer()
 
@@ -67,6 +67,29 @@ func TestUpsertBucketRetryPolicy(t *testing.T) {
 	}
 }
 
+func TestFileStreamRetryPolicy(t *testing.T) {
+	testCases := []struct {
+		name        string
+		statusCode  int
+		shouldRetry bool
+	}{
+		{"TooManyRequests", http.StatusTooManyRequests, false},
+		{"InternalServerError", http.StatusInternalServerError, true},
+		{"OK", http.StatusOK, false},
+	}
+
+	for _, tc := range testCases {
+		t.Run(tc.name, func(t *testing.T) {
+			resp := httptest.NewRecorder().Result()
+			resp.StatusCode = tc.statusCode
+
+			retry, _ := clients.FileStreamRetryPolicy(context.Background(), resp, nil)
+
+			assert.Equal(t, tc.shouldRetry, retry)
+		})
+	}
+}
+
 func TestCheckRetry(t *testing.T) {
 	// Mock a retry policy
 	mockRetryPolicy := func(ctx context.Context, resp *http.Response, err error) (bool, error) {
@@ -106,9 +106,9 @@ func (fm *fileTransferManager) Start() {
 	if fm.active {
 		return
 	}
+	fm.active = true
 	fm.wg.Add(1)
 	go func() {
-		fm.active = true
 		for task := range fm.inChan {
 			// add a task to the wait group
 			fm.wg.Add(1)
@@ -0,0 +1,58 @@
+package filetransfer
+
+import "sync"
+
+// sharedFileTransferManager adds tasks to a manager shared by several runs.
+type sharedFileTransferManager struct {
+	// manager is the shared manager
+	manager FileTransferManager
+
+	// fileTransferStats counts the uploads added through this manager
+	fileTransferStats FileTransferStats
+
+	// wg tracks the tasks added through this manager
+	wg sync.WaitGroup
+}
+
+// NewSharedFileTransferManager returns a FileTransferManager that schedules
+// its tasks on manager and counts its own uploads in stats.
+//
+// Start and Close do not start or stop the shared manager, which is owned
+// by the caller: Close only waits for the tasks added through the returned
+// manager. This lets several runs share one manager and its concurrency
+// limit.
+func NewSharedFileTransferManager(
+	manager FileTransferManager,
+	stats FileTransferStats,
+) FileTransferManager {
+	return &sharedFileTransferManager{
+		manager:           manager,
+		fileTransferStats: stats,
+	}
+}
+
+func (fm *sharedFileTransferManager) Start() {}
+
+func (fm *sharedFileTransferManager) AddTask(task *Task) {
+	fm.wg.Add(1)
+	callback := task.CompletionCallback
+	task.CompletionCallback = func(task *Task) {
+		defer fm.wg.Done()
+		if callback != nil {
+			callback(task)
+		}
+		if task.Type == UploadTask {
+			fm.fileTransferStats.UpdateUploadStats(FileUploadInfo{
+				FileKind:      task.FileKind,
+				Path:          task.Path,
+				UploadedBytes: task.Size,
+				TotalBytes:    task.Size,
+			})
+		}
+	}
+	fm.manager.AddTask(task)
+}
+
+func (fm *sharedFileTransferManager) Close() {
+	fm.wg.Wait()
+}
@@ -0,0 +1,84 @@
+package filetransfer_test
+
+import (
+	"sync"
+	"testing"
+
+	"github.com/stretchr/testify/assert"
+	"github.com/wandb/wandb/core/internal/filetransfer"
+	"github.com/wandb/wandb/core/pkg/observability"
+)
+
+type blockingFileTransfer struct {
+	release chan struct{}
+}
+
+func (ft *blockingFileTransfer) Upload(task *filetransfer.Task) error {
+	<-ft.release
+	return nil
+}
+
+func (ft *blockingFileTransfer) Download(task *filetransfer.Task) error {
+	return nil
+}
+
+func TestSharedFileTransferManager(t *testing.T) {
+	ft := &blockingFileTransfer{release: make(chan struct{})}
+	manager := filetransfer.NewFileTransferManager(
+		filetransfer.WithLogger(observability.NewNoOpLogger()),
+		filetransfer.WithFileTransfer(ft),
+		filetransfer.WithFileTransferStats(filetransfer.NewFileTransferStats()),
+	)
+	manager.Start()
+	defer manager.Close()
+
+	run1Stats := filetransfer.NewFileTransferStats()
+	run1 := filetransfer.NewSharedFileTransferManager(manager, run1Stats)
+	run2 := filetransfer.NewSharedFileTransferManager(
+		manager,
+		filetransfer.NewFileTransferStats(),
+	)
+
+	// Start and Close of a run must not stop the shared manager
+	run2.Start()
+	run2.Close()
+
+	mu := sync.Mutex{}
+	var completed []string
+	run1.AddTask(&filetransfer.Task{
+		Type: filetransfer.UploadTask,
+		Path: "file.txt",
+		Size: 10,
+		CompletionCallback: func(task *filetransfer.Task) {
+			mu.Lock()
+			defer mu.Unlock()
+			completed = append(completed, task.Path)
+		},
+	})
+
+	closed := make(chan struct{})
+	go func() {
+		run1.Close()
+		close(closed)
+	}()
+
+	select {
+	case <-closed:
+		t.Fatal("Close returned before the run's task finished")
+	default:
+	}
+
+	close(ft.release)
+	<-closed
+	assert.Equal(t, []string{"file.txt"}, completed)
+	assert.EqualValues(t, 10, run1Stats.GetFilesStats().GetUploadedBytes())
+
+	// the shared manager still accepts tasks
+	done := make(chan struct{})
+	run2.AddTask(&filetransfer.Task{
+		Type:               filetransfer.UploadTask,
+		CompletionCallback: func(*filetransfer.Task) { close(done) },
+	})
+	run2.Close()
+	<-done
+}
@@ -99,7 +99,7 @@ func (runConfig *RunConfig) ApplyChangeRecord(
 	}
 
 	for _, configItem := range configRecord.Remove {
-		runConfig.removeAtPath(keyPath(configItem))
+		runConfig.RemoveAtPath(keyPath(configItem))
 	}
 }
 
@@ -231,8 +231,19 @@ func updateAtPath(
 	return nil
 }
 
-// Removes the value at the path in the config tree.
-func (runConfig *RunConfig) removeAtPath(path RunConfigPath) {
+// Sets the value at the path in the config tree, creating the maps above it
+// and keeping the other values in them.
+//
+// Returns an error if there exists a non-map value above the path.
+func (runConfig *RunConfig) UpdateAtPath(
+	path RunConfigPath,
+	value interface{},
+) error {
+	return updateAtPath(runConfig.tree, path, value)
+}
+
+// Removes the value at the path in the config tree, if there is one.
+func (runConfig *RunConfig) RemoveAtPath(path RunConfigPath) {
 	pathPrefix := path[:len(path)-1]
 	key := path[len(path)-1]
 
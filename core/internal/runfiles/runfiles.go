@@ -74,4 +74,9 @@ type UploaderParams struct {
 
 	// Alternative to BatchWindow that specifies a waiting function.
 	BatchDelayFunc func() <-chan struct{}
+
+	// Called with the errors that keep files from being uploaded.
+	//
+	// It may be nil. It is called from separate goroutines.
+	OnError func(err error)
 }
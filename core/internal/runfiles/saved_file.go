@@ -1,6 +1,7 @@
 package runfiles
 
 import (
+	"fmt"
 	"sync"
 
 	"github.com/wandb/wandb/core/internal/filetransfer"
@@ -16,6 +17,9 @@ type savedFile struct {
 	ftm    filetransfer.FileTransferManager
 	logger *observability.CoreLogger
 
+	// Called with the error when an upload fails.
+	onError func(err error)
+
 	// The path to the actual file.
 	realPath string
 
@@ -48,6 +52,7 @@ func newSavedFile(
 	fs filestream.FileStream,
 	ftm filetransfer.FileTransferManager,
 	logger *observability.CoreLogger,
+	onError func(err error),
 	realPath string,
 	runPath string,
 ) *savedFile {
@@ -55,6 +60,7 @@ func newSavedFile(
 		fs:       fs,
 		ftm:      ftm,
 		logger:   logger,
+		onError:  onError,
 		realPath: realPath,
 		runPath:  runPath,
 
@@ -130,6 +136,8 @@ func (f *savedFile) doUpload() {
 func (f *savedFile) onFinishUpload(task *filetransfer.Task) {
 	if task.Err == nil {
 		f.fs.SignalFileUploaded(f.runPath)
+	} else {
+		f.onError(fmt.Errorf("runfiles: failed to upload %s: %v", f.runPath, task.Err))
 	}
 
 	f.Lock()
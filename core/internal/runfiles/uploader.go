@@ -45,6 +45,9 @@ type uploader struct {
 
 	// A watcher for 'live' mode files.
 	watcher watcher2.Watcher
+
+	// Called with the errors that keep files from being uploaded.
+	onError func(err error)
 }
 
 func newUploader(params UploaderParams) *uploader {
@@ -63,6 +66,11 @@ func newUploader(params UploaderParams) *uploader {
 		stateMu:  &sync.Mutex{},
 
 		watcher: params.FileWatcher,
+		onError: params.OnError,
+	}
+
+	if uploader.onError == nil {
+		uploader.onError = func(error) {}
 	}
 
 	if params.BatchWindow != 0 {
@@ -210,6 +218,7 @@ func (u *uploader) knownFile(runPath string) *savedFile {
 			u.fs,
 			u.ftm,
 			u.logger,
+			u.onError,
 			u.toRealPath(runPath),
 			runPath,
 		)
@@ -266,6 +275,7 @@ func (u *uploader) upload(relativePaths []string) {
 		)
 		if err != nil {
 			u.logger.CaptureError("runfiles: CreateRunFiles returned error", err)
+			u.onError(fmt.Errorf("runfiles: failed to create run files: %v", err))
 			u.uploadWG.Add(-len(relativePaths))
 			return
 		}
@@ -279,6 +289,11 @@ func (u *uploader) upload(relativePaths []string) {
 				"actual",
 				len(createRunFilesResponse.CreateRunFiles.Files),
 			)
+			u.onError(fmt.Errorf(
+				"runfiles: expected %d files to upload, got %d",
+				len(relativePaths),
+				len(createRunFilesResponse.CreateRunFiles.Files),
+			))
 			u.uploadWG.Add(-len(relativePaths))
 			return
 		}
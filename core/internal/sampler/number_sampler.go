@@ -0,0 +1,109 @@
+package sampler
+
+import "math"
+
+// NumberSampler samples a stream of numbers, keeping the range and the last
+// of all the numbers seen.
+//
+// Ints are also kept as int64, so that they are sampled exactly. NaN and
+// infinities are sampled like other numbers; NaN is left out of the range.
+type NumberSampler struct {
+	reservoir *ReservoirSampler[number]
+
+	// min and max are the range of the numbers other than NaN, which are
+	// NaN until such a number is seen
+	min, max float64
+
+	// last is the last number added
+	last float64
+
+	// onlyInts is whether every number added was an int
+	onlyInts bool
+}
+
+// number is a number in a NumberSampler.
+type number struct {
+	float float64
+
+	// int is the number if it was added as an int
+	int int64
+}
+
+func NewNumberSampler(k int) *NumberSampler {
+	return &NumberSampler{
+		reservoir: NewReservoirSampler[number](k),
+		min:       math.NaN(),
+		max:       math.NaN(),
+		last:      math.NaN(),
+		onlyInts:  true,
+	}
+}
+
+// AddInt adds an int to the stream.
+func (s *NumberSampler) AddInt(value int64) {
+	s.add(number{float: float64(value), int: value})
+}
+
+// AddFloat adds a float to the stream.
+func (s *NumberSampler) AddFloat(value float64) {
+	s.onlyInts = false
+	s.add(number{float: value})
+}
+
+func (s *NumberSampler) add(n number) {
+	s.reservoir.Add(n)
+
+	value := n.float
+	s.last = value
+	if math.IsNaN(value) {
+		return
+	}
+	if math.IsNaN(s.min) || value < s.min {
+		s.min = value
+	}
+	if math.IsNaN(s.max) || value > s.max {
+		s.max = value
+	}
+}
+
+// Sample returns up to k of the numbers, in the order they were added.
+func (s *NumberSampler) Sample() []float64 {
+	numbers := s.reservoir.Sample()
+	samples := make([]float64, len(numbers))
+	for i, n := range numbers {
+		samples[i] = n.float
+	}
+	return samples
+}
+
+// SampleInts is like Sample, for a stream in which every number was an int.
+//
+// The ints are exact, where the floats of Sample are not beyond 2^53.
+func (s *NumberSampler) SampleInts() []int64 {
+	numbers := s.reservoir.Sample()
+	samples := make([]int64, len(numbers))
+	for i, n := range numbers {
+		samples[i] = n.int
+	}
+	return samples
+}
+
+// IsInt returns whether every number added was an int.
+func (s *NumberSampler) IsInt() bool {
+	return s.onlyInts
+}
+
+// Min returns the least number added, not counting NaN.
+func (s *NumberSampler) Min() float64 {
+	return s.min
+}
+
+// Max returns the greatest number added, not counting NaN.
+func (s *NumberSampler) Max() float64 {
+	return s.max
+}
+
+// Last returns the last number added.
+func (s *NumberSampler) Last() float64 {
+	return s.last
+}
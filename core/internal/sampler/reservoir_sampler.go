@@ -0,0 +1,74 @@
+package sampler
+
+import (
+	"math/rand"
+	"sort"
+)
+
+// ReservoirSampler is a data structure that samples k items from a stream of items.
+//
+// It implements Algorithm R: the first k items fill the reservoir, and the
+// n-th item after that replaces a random item of the reservoir with
+// probability k/n. Every item seen so far is then in the reservoir with the
+// same probability, and the reservoir never holds more than k items.
+type ReservoirSampler[T any] struct {
+
+	// items are the items in the reservoir
+	items []item[T]
+
+	// k is the upper bound on the number of items in the reservoir
+	k int
+
+	// seen is a counter for the number of items seen thus far
+	seen int
+}
+
+// item is an item in the reservoir.
+type item[T any] struct {
+	value T
+
+	// index is the order in which the item was added
+	index int
+}
+
+func NewReservoirSampler[T any](k int) *ReservoirSampler[T] {
+	return &ReservoirSampler[T]{
+		items: make([]item[T], 0, max(k, 0)),
+		k:     k,
+	}
+}
+
+// Add adds a new item to the stream, which may go in the reservoir.
+func (rs *ReservoirSampler[T]) Add(value T) {
+	index := rs.seen
+	rs.seen++
+
+	switch {
+	case len(rs.items) < rs.k:
+		rs.items = append(rs.items, item[T]{value: value, index: index})
+	case rs.k > 0:
+		if i := rand.Intn(rs.seen); i < rs.k {
+			rs.items[i] = item[T]{value: value, index: index}
+		}
+	}
+}
+
+// Seen returns the number of items added.
+func (rs *ReservoirSampler[T]) Seen() int {
+	return rs.seen
+}
+
+// Sample returns the items in the reservoir, in the order they were added.
+func (rs *ReservoirSampler[T]) Sample() []T {
+	items := make([]item[T], len(rs.items))
+	copy(items, rs.items)
+	sort.Slice(items, func(i, j int) bool {
+		return items[i].index < items[j].index
+	})
+
+	samples := make([]T, len(items))
+	for i, item := range items {
+		samples[i] = item.value
+	}
+	return samples
+}
@@ -0,0 +1,82 @@
+package sampler_test
+
+import (
+	"math"
+	"sort"
+	"testing"
+
+	"github.com/stretchr/testify/assert"
+
+	"github.com/wandb/wandb/core/internal/sampler"
+)
+
+func TestReservoirKeepsAtMostK(t *testing.T) {
+	rs := sampler.NewReservoirSampler[int](48)
+	for i := 0; i < 10_000; i++ {
+		rs.Add(i)
+	}
+
+	samples := rs.Sample()
+	assert.Len(t, samples, 48)
+	assert.True(t, sort.IntsAreSorted(samples))
+	assert.Equal(t, 10_000, rs.Seen())
+}
+
+func TestReservoirKeepsEverythingWhenFewerThanK(t *testing.T) {
+	rs := sampler.NewReservoirSampler[int](48)
+	for i := 0; i < 10; i++ {
+		rs.Add(i)
+	}
+
+	assert.Equal(t, []int{0, 1, 2, 3, 4, 5, 6, 7, 8, 9}, rs.Sample())
+}
+
+func TestReservoirIsUniform(t *testing.T) {
+	const k, n, trials = 10, 100, 20_000
+	counts := make([]int, n)
+	for trial := 0; trial < trials; trial++ {
+		rs := sampler.NewReservoirSampler[int](k)
+		for i := 0; i < n; i++ {
+			rs.Add(i)
+		}
+		for _, i := range rs.Sample() {
+			counts[i]++
+		}
+	}
+
+	// each item is kept with probability k/n; the bounds are many standard
+	// deviations away
+	expected := trials * k / n
+	for i, count := range counts {
+		assert.InDelta(t, expected, count, 0.15*float64(expected), "item %d", i)
+	}
+}
+
+func TestNumberSampler(t *testing.T) {
+	s := sampler.NewNumberSampler(48)
+	s.AddInt(3)
+	s.AddInt(1 << 40)
+	s.AddInt(math.MaxInt64 - 1)
+	assert.True(t, s.IsInt())
+	assert.Equal(t, []int64{3, 1 << 40, math.MaxInt64 - 1}, s.SampleInts())
+	assert.Equal(t, []float64{3, 1 << 40, math.MaxInt64 - 1}, s.Sample())
+
+	s.AddFloat(math.NaN())
+	s.AddFloat(-0.1234567890123)
+	s.AddFloat(math.Inf(1))
+	s.AddFloat(math.NaN())
+
+	assert.False(t, s.IsInt())
+	assert.Equal(t, -0.1234567890123, s.Min())
+	assert.Equal(t, math.Inf(1), s.Max())
+	assert.True(t, math.IsNaN(s.Last()))
+	assert.Len(t, s.Sample(), 7)
+}
+
+func TestNumberSamplerWithOnlyNaN(t *testing.T) {
+	s := sampler.NewNumberSampler(48)
+	s.AddFloat(math.NaN())
+
+	assert.True(t, math.IsNaN(s.Min()))
+	assert.True(t, math.IsNaN(s.Max()))
+}
@@ -0,0 +1,291 @@
+// Package summarystore keeps the summary of a run, spilling values to disk
+// so that runs with many summary keys use bounded memory.
+package summarystore
+
+import (
+	"container/list"
+	"fmt"
+	"io"
+	"os"
+	"sort"
+)
+
+const (
+	// defaultMaxHotBytes is the size of the values kept in memory if
+	// Params.MaxHotBytes is not set.
+	defaultMaxHotBytes = 32 << 20
+
+	// minCompactBytes is how much of the spill file must be unused values
+	// before the file is compacted, as well as more than half of it.
+	minCompactBytes = 1 << 20
+)
+
+// Params are the parameters of a Store.
+type Params struct {
+	// Dir is the directory to create the spill file in, or the empty string
+	// for the system's temporary directory.
+	Dir string
+
+	// MaxHotBytes is the total size of the values to keep in memory; the
+	// least recently used values beyond it are spilled to disk.
+	MaxHotBytes int
+}
+
+// Store maps summary keys to their JSON values.
+//
+// The most recently used values are kept in memory, up to a total size, and
+// the others are written to a spill file. Keys are always in memory. The
+// spill file is created when a value is first spilled and is removed by
+// Close.
+//
+// A Store is not safe for concurrent use.
+type Store struct {
+	dir         string
+	maxHotBytes int
+
+	entries map[string]*entry
+
+	// hot is the entries with their value in memory, most recently used
+	// first, and hotBytes is the size of their values
+	hot      *list.List
+	hotBytes int
+
+	// file is the spill file, size is its size and unused is the number of
+	// bytes in it of values that have since changed
+	file   *os.File
+	size   int64
+	unused int64
+}
+
+// entry is the value of a key.
+type entry struct {
+	key string
+
+	// value is the value, if it is in memory, in which case elem is the
+	// entry's place in the hot list
+	value string
+	elem  *list.Element
+
+	// offset and length locate the value in the spill file; length is
+	// negative if the file does not have the current value
+	offset int64
+	length int
+}
+
+func New(params Params) *Store {
+	maxHotBytes := params.MaxHotBytes
+	if maxHotBytes <= 0 {
+		maxHotBytes = defaultMaxHotBytes
+	}
+	return &Store{
+		dir:         params.Dir,
+		maxHotBytes: maxHotBytes,
+		entries:     make(map[string]*entry),
+		hot:         list.New(),
+	}
+}
+
+// Len returns the number of keys.
+func (s *Store) Len() int {
+	return len(s.entries)
+}
+
+// Get returns the value of a key.
+func (s *Store) Get(key string) (string, bool, error) {
+	e, ok := s.entries[key]
+	if !ok {
+		return "", false, nil
+	}
+	if e.elem != nil {
+		s.hot.MoveToFront(e.elem)
+		return e.value, true, nil
+	}
+
+	value, err := s.read(e)
+	if err != nil {
+		return "", false, err
+	}
+	s.makeHot(e, value)
+	return value, true, s.spill()
+}
+
+// Set sets the value of a key.
+//
+// Values that do not fit in memory are spilled to disk. If that fails, the
+// value is still set, and stays in memory.
+func (s *Store) Set(key, value string) error {
+	e, ok := s.entries[key]
+	if !ok {
+		e = &entry{key: key, length: -1}
+		s.entries[key] = e
+	}
+	if e.length >= 0 {
+		s.unused += int64(e.length)
+		e.length = -1
+	}
+	s.makeHot(e, value)
+	return s.spill()
+}
+
+// Delete removes a key.
+func (s *Store) Delete(key string) {
+	e, ok := s.entries[key]
+	if !ok {
+		return
+	}
+	if e.elem != nil {
+		s.hot.Remove(e.elem)
+		s.hotBytes -= len(e.value)
+	}
+	if e.length >= 0 {
+		s.unused += int64(e.length)
+	}
+	delete(s.entries, key)
+}
+
+// Range calls f for each key and value, in order of the keys, and stops at
+// the first error.
+//
+// Values on disk are read one at a time and are not brought into memory.
+func (s *Store) Range(f func(key, value string) error) error {
+	keys := make([]string, 0, len(s.entries))
+	for key := range s.entries {
+		keys = append(keys, key)
+	}
+	sort.Strings(keys)
+
+	for _, key := range keys {
+		e := s.entries[key]
+		value := e.value
+		if e.elem == nil {
+			var err error
+			if value, err = s.read(e); err != nil {
+				return err
+			}
+		}
+		if err := f(key, value); err != nil {
+			return err
+		}
+	}
+	return nil
+}
+
+// Close removes the spill file.
+func (s *Store) Close() error {
+	if s.file == nil {
+		return nil
+	}
+	name := s.file.Name()
+	err := s.file.Close()
+	s.file = nil
+	if removeErr := os.Remove(name); err == nil {
+		err = removeErr
+	}
+	return err
+}
+
+// makeHot sets the value of an entry in memory and marks it as the most
+// recently used.
+func (s *Store) makeHot(e *entry, value string) {
+	if e.elem == nil {
+		e.elem = s.hot.PushFront(e)
+	} else {
+		s.hot.MoveToFront(e.elem)
+		s.hotBytes -= len(e.value)
+	}
+	e.value = value
+	s.hotBytes += len(value)
+}
+
+// spill writes the least recently used values to disk until the rest fit
+// in memory.
+//
+// The most recently used value always stays in memory.
+func (s *Store) spill() error {
+	for s.hotBytes > s.maxHotBytes && s.hot.Len() > 1 {
+		e := s.hot.Back().Value.(*entry)
+		if e.length < 0 {
+			if err := s.write(e); err != nil {
+				return err
+			}
+		}
+		s.hot.Remove(e.elem)
+		s.hotBytes -= len(e.value)
+		e.elem = nil
+		e.value = ""
+	}
+
+	if s.unused > minCompactBytes && s.unused > s.size/2 {
+		return s.compact()
+	}
+	return nil
+}
+
+// write appends the value of an entry to the spill file.
+func (s *Store) write(e *entry) error {
+	if s.file == nil {
+		file, err := os.CreateTemp(s.dir, "summary-*.spill")
+		if err != nil {
+			return fmt.Errorf("summarystore: failed to create spill file: %v", err)
+		}
+		s.file = file
+	}
+
+	if _, err := s.file.WriteAt([]byte(e.value), s.size); err != nil {
+		return fmt.Errorf("summarystore: failed to spill value: %v", err)
+	}
+	e.offset = s.size
+	e.length = len(e.value)
+	s.size += int64(e.length)
+	return nil
+}
+
+// read reads the value of an entry from the spill file.
+func (s *Store) read(e *entry) (string, error) {
+	buf := make([]byte, e.length)
+	if _, err := s.file.ReadAt(buf, e.offset); err != nil && err != io.EOF {
+		return "", fmt.Errorf("summarystore: failed to read value of %q: %v", e.key, err)
+	}
+	return string(buf), nil
+}
+
+// compact rewrites the spill file without the values that have changed.
+//
+// On failure, the old file is kept.
+func (s *Store) compact() error {
+	file, err := os.CreateTemp(s.dir, "summary-*.spill")
+	if err != nil {
+		return fmt.Errorf("summarystore: failed to compact spill file: %v", err)
+	}
+
+	offsets := make(map[*entry]int64)
+	var size int64
+	for _, e := range s.entries {
+		if e.length < 0 {
+			continue
+		}
+		value, err := s.read(e)
+		if err == nil {
+			_, err = file.WriteAt([]byte(value), size)
+		}
+		if err != nil {
+			_ = file.Close()
+			_ = os.Remove(file.Name())
+			return fmt.Errorf("summarystore: failed to compact spill file: %v", err)
+		}
+		offsets[e] = size
+		size += int64(e.length)
+	}
+
+	for e, offset := range offsets {
+		e.offset = offset
+	}
+	old := s.file
+	s.file, s.size, s.unused = file, size, 0
+
+	_ = old.Close()
+	if err := os.Remove(old.Name()); err != nil {
+		return fmt.Errorf("summarystore: failed to remove old spill file: %v", err)
+	}
+	return nil
+}
@@ -0,0 +1,110 @@
+package summarystore_test
+
+import (
+	"fmt"
+	"os"
+	"path/filepath"
+	"strings"
+	"testing"
+
+	"github.com/stretchr/testify/assert"
+	"github.com/stretchr/testify/require"
+
+	"github.com/wandb/wandb/core/internal/summarystore"
+)
+
+// spillFiles returns the sizes of the spill files in a directory.
+func spillFiles(t *testing.T, dir string) []int64 {
+	names, err := filepath.Glob(filepath.Join(dir, "summary-*.spill"))
+	require.NoError(t, err)
+	sizes := make([]int64, len(names))
+	for i, name := range names {
+		info, err := os.Stat(name)
+		require.NoError(t, err)
+		sizes[i] = info.Size()
+	}
+	return sizes
+}
+
+func TestValuesSpillToDisk(t *testing.T) {
+	dir := t.TempDir()
+	store := summarystore.New(summarystore.Params{Dir: dir, MaxHotBytes: 16})
+
+	assert.NoError(t, store.Set("b", "2"))
+	assert.Empty(t, spillFiles(t, dir))
+
+	for i := 0; i < 100; i++ {
+		assert.NoError(t, store.Set(fmt.Sprintf("key%03d", i), fmt.Sprintf("%d", i*10)))
+	}
+	assert.NoError(t, store.Set("a", `"first"`))
+	assert.Equal(t, 102, store.Len())
+	assert.Len(t, spillFiles(t, dir), 1)
+
+	value, ok, err := store.Get("key042")
+	assert.NoError(t, err)
+	assert.True(t, ok)
+	assert.Equal(t, "420", value)
+
+	_, ok, err = store.Get("missing")
+	assert.NoError(t, err)
+	assert.False(t, ok)
+
+	var keys, values []string
+	assert.NoError(t, store.Range(func(key, value string) error {
+		keys = append(keys, key)
+		values = append(values, value)
+		return nil
+	}))
+	assert.Len(t, keys, 102)
+	assert.Equal(t, []string{"a", "b", "key000"}, keys[:3])
+	assert.Equal(t, []string{`"first"`, "2", "0"}, values[:3])
+	assert.Equal(t, "key099", keys[101])
+	assert.Equal(t, "990", values[101])
+
+	assert.NoError(t, store.Close())
+	assert.Empty(t, spillFiles(t, dir))
+}
+
+func TestUpdateAndDeleteSpilledValues(t *testing.T) {
+	dir := t.TempDir()
+	store := summarystore.New(summarystore.Params{Dir: dir, MaxHotBytes: 4})
+
+	assert.NoError(t, store.Set("a", "1111"))
+	assert.NoError(t, store.Set("b", "2222"))
+	assert.NoError(t, store.Set("a", "3333"))
+	assert.NoError(t, store.Set("c", "4444"))
+
+	store.Delete("b")
+	_, ok, err := store.Get("b")
+	assert.NoError(t, err)
+	assert.False(t, ok)
+	assert.Equal(t, 2, store.Len())
+
+	for key, expected := range map[string]string{"a": "3333", "c": "4444"} {
+		value, ok, err := store.Get(key)
+		assert.NoError(t, err)
+		assert.True(t, ok)
+		assert.Equal(t, expected, value)
+	}
+	assert.NoError(t, store.Close())
+}
+
+func TestSpillFileIsCompacted(t *testing.T) {
+	dir := t.TempDir()
+	store := summarystore.New(summarystore.Params{Dir: dir, MaxHotBytes: 1})
+
+	big := strings.Repeat("x", 64<<10)
+	for i := 0; i < 100; i++ {
+		assert.NoError(t, store.Set("a", fmt.Sprintf(`"%s%d"`, big, i)))
+		assert.NoError(t, store.Set("b", fmt.Sprintf("%d", i)))
+	}
+
+	sizes := spillFiles(t, dir)
+	require.Len(t, sizes, 1)
+	assert.Less(t, sizes[0], int64(2<<20))
+
+	value, _, err := store.Get("a")
+	assert.NoError(t, err)
+	assert.Equal(t, fmt.Sprintf(`"%s99"`, big), value)
+	assert.NoError(t, store.Close())
+}
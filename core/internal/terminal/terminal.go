@@ -0,0 +1,398 @@
+// Package terminal emulates a terminal to turn console output into the
+// lines that a user would see.
+//
+// Programs such as progress bars rewrite what they printed with carriage
+// returns and ANSI escape codes. Saving their output as is turns one
+// progress bar into thousands of lines; emulating the terminal instead
+// gives the lines as they end up on the screen.
+package terminal
+
+import (
+	"strconv"
+	"strings"
+	"unicode/utf8"
+)
+
+// tabWidth is the distance between tab stops.
+const tabWidth = 8
+
+// Params are the parameters of a Terminal.
+type Params struct {
+	// Width is the number of columns after which lines wrap, or zero for
+	// lines of any length.
+	Width int
+
+	// Height is the number of lines at the bottom of the output that the
+	// cursor can move to; the lines above them can no longer change.
+	Height int
+}
+
+// Line is a line of the output.
+type Line struct {
+	// Num is the number of the line, counting from zero.
+	Num int
+
+	// Content is the text of the line, with the escape codes that style it.
+	Content string
+}
+
+// cell is a character on the screen.
+type cell struct {
+	r rune
+
+	// style is the escape codes that set the style of the character
+	style string
+}
+
+// Terminal emulates the screen of a terminal.
+//
+// It handles carriage returns, backspaces and the common escape codes for
+// moving the cursor, erasing and styling text. Other escape codes are
+// dropped.
+//
+// A Terminal is not safe for concurrent use.
+type Terminal struct {
+	width  int
+	height int
+
+	// screen is the lines the cursor can move to, starting at line first
+	screen [][]cell
+	first  int
+
+	// dirty is the set of lines changed since they were last read
+	dirty map[int]bool
+
+	// scrolled is the changed lines that scrolled off the screen since the
+	// last read
+	scrolled []Line
+
+	// unread is the number of the first line never read
+	unread int
+
+	// x and y are the column and the line of the cursor
+	x, y int
+
+	// style is the escape codes for the style of the next characters
+	style string
+
+	// pending is an escape sequence cut off at the end of the last write
+	pending string
+}
+
+func New(params Params) *Terminal {
+	height := params.Height
+	if height <= 0 {
+		height = 1
+	}
+	t := &Terminal{
+		width:  params.Width,
+		height: height,
+		dirty:  make(map[int]bool),
+	}
+	t.moveTo(0)
+	return t
+}
+
+// FirstLine returns the number of the first line that can still change.
+func (t *Terminal) FirstLine() int {
+	return t.first
+}
+
+// Write processes output written to the terminal.
+//
+// Output may be split anywhere, including in the middle of an escape
+// sequence.
+func (t *Terminal) Write(output string) {
+	output = t.pending + output
+	t.pending = ""
+
+	for i := 0; i < len(output); {
+		if output[i] == '\x1b' {
+			n, ok := t.escape(output[i:])
+			if !ok {
+				t.pending = output[i:]
+				return
+			}
+			i += n
+			continue
+		}
+
+		r, size := utf8.DecodeRuneInString(output[i:])
+		i += size
+
+		switch r {
+		case '\n':
+			t.x = 0
+			t.moveTo(t.y + 1)
+		case '\r':
+			t.x = 0
+		case '\b':
+			t.x = max(0, t.x-1)
+		case '\t':
+			t.x = (t.x/tabWidth + 1) * tabWidth
+		default:
+			if r >= 0x20 && r != 0x7f {
+				t.put(r)
+			}
+		}
+	}
+}
+
+// Read returns the lines that changed since the last read and that are
+// settled, which is all of them but the last line, which is still being
+// written.
+//
+// Lines are returned in order, and every line is returned at least once
+// before any line after it.
+func (t *Terminal) Read() []Line {
+	return t.read(false)
+}
+
+// Flush returns all lines that changed since the last read, including the
+// last line unless it is a new empty line.
+func (t *Terminal) Flush() []Line {
+	return t.read(true)
+}
+
+func (t *Terminal) read(all bool) []Line {
+	lines := t.scrolled
+	t.scrolled = nil
+
+	for i, line := range t.screen {
+		num := t.first + i
+		if !t.dirty[num] {
+			continue
+		}
+		if num == t.last() && (!all || len(line) == 0 && num >= t.unread) {
+			continue
+		}
+		lines = append(lines, Line{Num: num, Content: render(line)})
+		delete(t.dirty, num)
+	}
+
+	if len(lines) > 0 {
+		t.unread = max(t.unread, lines[len(lines)-1].Num+1)
+	}
+	return lines
+}
+
+// last returns the number of the last line.
+func (t *Terminal) last() int {
+	return t.first + len(t.screen) - 1
+}
+
+// put writes a character at the cursor and moves the cursor right.
+func (t *Terminal) put(r rune) {
+	if t.width > 0 && t.x >= t.width {
+		t.x = 0
+		t.moveTo(t.y + 1)
+	}
+
+	line := t.screen[t.y-t.first]
+	for len(line) <= t.x {
+		line = append(line, cell{})
+	}
+	line[t.x] = cell{r: r, style: t.style}
+	t.screen[t.y-t.first] = line
+	t.dirty[t.y] = true
+	t.x++
+}
+
+// moveTo moves the cursor to a line, adding lines to the screen and
+// scrolling lines off it as needed.
+func (t *Terminal) moveTo(y int) {
+	y = max(y, t.first)
+	for t.last() < y {
+		t.screen = append(t.screen, nil)
+		t.dirty[t.last()] = true
+	}
+	for len(t.screen) > t.height && t.first < y {
+		if t.dirty[t.first] {
+			t.scrolled = append(t.scrolled, Line{Num: t.first, Content: render(t.screen[0])})
+			delete(t.dirty, t.first)
+		}
+		t.screen = t.screen[1:]
+		t.first++
+	}
+	t.y = y
+}
+
+// escape handles the escape sequence at the start of the output.
+//
+// It returns the length of the sequence, or false if the output ends
+// before the sequence does.
+func (t *Terminal) escape(output string) (int, bool) {
+	if len(output) < 2 {
+		return 0, false
+	}
+
+	switch output[1] {
+	case '[':
+		// Control Sequence Introducer: parameters, then a final byte
+		for i := 2; i < len(output); i++ {
+			if c := output[i]; c >= 0x40 && c <= 0x7e {
+				t.control(output[2:i], c)
+				return i + 1, true
+			}
+		}
+		return 0, false
+
+	case ']':
+		// Operating System Command, such as setting the window title or a
+		// hyperlink, ended by BEL or ESC \
+		for i := 2; i < len(output); i++ {
+			switch {
+			case output[i] == '\a':
+				return i + 1, true
+			case output[i] == '\x1b' && i+1 < len(output) && output[i+1] == '\\':
+				return i + 2, true
+			}
+		}
+		return 0, false
+
+	default:
+		return 2, true
+	}
+}
+
+// control handles a control sequence.
+func (t *Terminal) control(params string, command byte) {
+	if command == 'm' {
+		t.setStyle(params)
+		return
+	}
+
+	args := parseArgs(params)
+	arg := func(i, def int) int {
+		if i < len(args) && args[i] > 0 {
+			return args[i]
+		}
+		return def
+	}
+
+	switch command {
+	case 'A': // cursor up
+		t.moveTo(t.y - arg(0, 1))
+	case 'B': // cursor down
+		t.moveTo(t.y + arg(0, 1))
+	case 'C': // cursor forward
+		t.x += arg(0, 1)
+		if t.width > 0 {
+			t.x = min(t.x, t.width-1)
+		}
+	case 'D': // cursor back
+		t.x = max(0, t.x-arg(0, 1))
+	case 'E': // cursor to the start of a later line
+		t.x = 0
+		t.moveTo(t.y + arg(0, 1))
+	case 'F': // cursor to the start of an earlier line
+		t.x = 0
+		t.moveTo(t.y - arg(0, 1))
+	case 'G': // cursor to a column
+		t.x = arg(0, 1) - 1
+	case 'H', 'f': // cursor to a position on the screen
+		t.x = arg(1, 1) - 1
+		t.moveTo(t.first + arg(0, 1) - 1)
+	case 'J': // erase in display
+		t.eraseDisplay(arg(0, 0))
+	case 'K': // erase in line
+		t.eraseLine(t.y, arg(0, 0))
+	}
+}
+
+// eraseDisplay erases after the cursor (mode 0), before the cursor (mode 1)
+// or the whole screen (mode 2 and 3).
+func (t *Terminal) eraseDisplay(mode int) {
+	switch mode {
+	case 0:
+		t.eraseLine(t.y, 0)
+		for y := t.y + 1; y <= t.last(); y++ {
+			t.eraseLine(y, 2)
+		}
+	case 1:
+		for y := t.first; y < t.y; y++ {
+			t.eraseLine(y, 2)
+		}
+		t.eraseLine(t.y, 1)
+	default:
+		for y := t.first; y <= t.last(); y++ {
+			t.eraseLine(y, 2)
+		}
+	}
+}
+
+// eraseLine erases a line after the cursor (mode 0), before the cursor
+// (mode 1) or entirely (mode 2).
+func (t *Terminal) eraseLine(y int, mode int) {
+	line := t.screen[y-t.first]
+	switch {
+	case mode == 0 && t.x < len(line):
+		line = line[:t.x]
+	case mode == 1:
+		for x := 0; x <= t.x && x < len(line); x++ {
+			line[x] = cell{}
+		}
+	case mode == 2:
+		line = nil
+	default:
+		return
+	}
+	t.screen[y-t.first] = line
+	t.dirty[y] = true
+}
+
+// setStyle handles a Select Graphic Rendition sequence.
+//
+// Styles are kept as the sequences that set them, since they are written
+// out again as they are.
+func (t *Terminal) setStyle(params string) {
+	if params == "" || params == "0" {
+		t.style = ""
+		return
+	}
+	t.style += "\x1b[" + params + "m"
+}
+
+// parseArgs parses the numeric parameters of a control sequence; missing
+// or invalid parameters are zero.
+func parseArgs(params string) []int {
+	if params == "" {
+		return nil
+	}
+	fields := strings.Split(params, ";")
+	args := make([]int, len(fields))
+	for i, field := range fields {
+		args[i], _ = strconv.Atoi(field)
+	}
+	return args
+}
+
+// render returns the text of a line, with trailing blanks removed.
+func render(line []cell) string {
+	end := len(line)
+	for end > 0 && line[end-1].style == "" &&
+		(line[end-1].r == 0 || line[end-1].r == ' ') {
+		end--
+	}
+
+	var b strings.Builder
+	style := ""
+	for _, c := range line[:end] {
+		if c.style != style {
+			if style != "" {
+				b.WriteString("\x1b[0m")
+			}
+			b.WriteString(c.style)
+			style = c.style
+		}
+		if c.r == 0 {
+			b.WriteRune(' ')
+		} else {
+			b.WriteRune(c.r)
+		}
+	}
+	if style != "" {
+		b.WriteString("\x1b[0m")
+	}
+	return b.String()
+}
@@ -0,0 +1,120 @@
+package terminal_test
+
+import (
+	"strings"
+	"testing"
+
+	"github.com/stretchr/testify/assert"
+
+	"github.com/wandb/wandb/core/internal/terminal"
+)
+
+// contents returns the content of each line.
+func contents(lines []terminal.Line) []string {
+	result := make([]string, len(lines))
+	for i, line := range lines {
+		result[i] = line.Content
+	}
+	return result
+}
+
+func TestLinesSettleAfterNewline(t *testing.T) {
+	term := terminal.New(terminal.Params{Height: 10})
+
+	term.Write("first")
+	assert.Empty(t, term.Read())
+
+	term.Write("\nsecond\n")
+	assert.Equal(t,
+		[]terminal.Line{{Num: 0, Content: "first"}, {Num: 1, Content: "second"}},
+		term.Read())
+	assert.Empty(t, term.Flush())
+}
+
+func TestCarriageReturnRewritesLine(t *testing.T) {
+	term := terminal.New(terminal.Params{Height: 10})
+
+	for i := 0; i <= 100; i += 10 {
+		term.Write("\rprogress " + strings.Repeat("#", i/10))
+	}
+	term.Write("\rdone\n")
+
+	// the rest of the line is kept, as on a terminal
+	assert.Equal(t, []string{"doneress ##########"}, contents(term.Read()))
+}
+
+func TestFlushIncludesLastLine(t *testing.T) {
+	term := terminal.New(terminal.Params{Height: 10})
+
+	term.Write("a\n50%")
+	assert.Equal(t, []string{"a", "50%"}, contents(term.Flush()))
+
+	term.Write("\r100%\n")
+	assert.Equal(t, []terminal.Line{{Num: 1, Content: "100%"}}, term.Read())
+}
+
+func TestEraseAndCursorMovement(t *testing.T) {
+	term := terminal.New(terminal.Params{Height: 10})
+
+	// two progress bars, redrawn in place
+	term.Write("bar1 0%\nbar2 0%\n")
+	assert.Equal(t, []string{"bar1 0%", "bar2 0%"}, contents(term.Read()))
+
+	term.Write("\x1b[2A\x1b[2Kbar1 50%\n\x1b[Kbar2 10%\n")
+	assert.Equal(t,
+		[]terminal.Line{{Num: 0, Content: "bar1 50%"}, {Num: 1, Content: "bar2 10%"}},
+		term.Read())
+
+	term.Write("abcdef\x1b[3D\x1b[K\x1b[1Gx\bY")
+	assert.Equal(t, []string{"Ybc"}, contents(term.Flush()))
+}
+
+func TestEscapeSequenceSplitAcrossWrites(t *testing.T) {
+	term := terminal.New(terminal.Params{Height: 10})
+
+	term.Write("abc\x1b[")
+	term.Write("1D\x1b]0;window title\x07")
+	term.Write("X\n")
+
+	assert.Equal(t, []string{"abX"}, contents(term.Read()))
+}
+
+func TestStylesAreKept(t *testing.T) {
+	term := terminal.New(terminal.Params{Height: 10})
+
+	term.Write("\x1b[31mred\x1b[0m plain \x1b[1m\x1b[32mbold\x1b[m\n")
+
+	assert.Equal(t,
+		[]string{"\x1b[31mred\x1b[0m plain \x1b[1m\x1b[32mbold\x1b[0m"},
+		contents(term.Read()))
+}
+
+func TestLongLinesWrap(t *testing.T) {
+	term := terminal.New(terminal.Params{Width: 4, Height: 10})
+
+	term.Write("abcdefghij\n")
+
+	assert.Equal(t, []string{"abcd", "efgh", "ij"}, contents(term.Read()))
+}
+
+func TestLinesScrollOffScreen(t *testing.T) {
+	term := terminal.New(terminal.Params{Height: 3})
+
+	for i := 0; i < 5; i++ {
+		term.Write("line\n")
+	}
+	assert.Equal(t, 3, term.FirstLine())
+	assert.Len(t, term.Read(), 5)
+
+	// the cursor cannot go back to lines that scrolled off
+	term.Write("\x1b[10Aover\n")
+	assert.Equal(t, []terminal.Line{{Num: 3, Content: "over"}}, term.Read())
+}
+
+func TestEmptyLinesAreKept(t *testing.T) {
+	term := terminal.New(terminal.Params{Height: 10})
+
+	term.Write("a\n\n\tb\n")
+
+	assert.Equal(t, []string{"a", "", "        b"}, contents(term.Read()))
+}
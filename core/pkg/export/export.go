@@ -0,0 +1,330 @@
+// Package export converts the transaction log of a run into plain files
+// that can be read without W&B tooling.
+package export
+
+import (
+	"bufio"
+	"context"
+	"fmt"
+	"io"
+	"os"
+	"path/filepath"
+	"strconv"
+
+	"github.com/segmentio/encoding/json"
+	"google.golang.org/protobuf/proto"
+
+	"github.com/wandb/wandb/core/internal/corelib"
+	"github.com/wandb/wandb/core/internal/runconfig"
+	"github.com/wandb/wandb/core/internal/summarystore"
+	"github.com/wandb/wandb/core/pkg/observability"
+	"github.com/wandb/wandb/core/pkg/server"
+	"github.com/wandb/wandb/core/pkg/service"
+)
+
+const (
+	// HistoryFileName has one JSON object per history row
+	HistoryFileName = "history.jsonl"
+
+	// SummaryFileName has the run's final summary as a JSON object
+	SummaryFileName = "summary.json"
+
+	// ConfigFileName has the run's config in the format uploaded to W&B
+	ConfigFileName = "config.yaml"
+
+	// SystemMetricsFileName has one JSON object per system metrics sample
+	SystemMetricsFileName = "system_metrics.jsonl"
+
+	// OutputFileName has the console output of the run
+	OutputFileName = "output.log"
+
+	// ArtifactsFileName has a JSON array of the artifacts the run logged or used
+	ArtifactsFileName = "artifacts.json"
+)
+
+// Stats counts what was exported.
+type Stats struct {
+	// Records is the number of records read from the log
+	Records int
+
+	// Corrupt is the number of records that could not be read
+	Corrupt int
+
+	// History is the number of history rows
+	History int
+
+	// SystemMetrics is the number of system metrics samples
+	SystemMetrics int
+
+	// Output is the number of console output lines
+	Output int
+
+	// Artifacts is the number of artifacts
+	Artifacts int
+}
+
+// Artifact is an entry of the artifacts index.
+type Artifact struct {
+	// Use is "logged" for artifacts created by the run and "used" for
+	// artifacts it used
+	Use string `json:"use"`
+
+	Id          string   `json:"id,omitempty"`
+	Name        string   `json:"name"`
+	Type        string   `json:"type"`
+	Digest      string   `json:"digest,omitempty"`
+	Description string   `json:"description,omitempty"`
+	Metadata    string   `json:"metadata,omitempty"`
+	Aliases     []string `json:"aliases,omitempty"`
+}
+
+// exporter writes the records of a log to the export files.
+type exporter struct {
+	logger *observability.CoreLogger
+
+	// stats counts the exported records
+	stats Stats
+
+	// err is the first error writing the files
+	err error
+
+	// history is the writer for history.jsonl
+	history *bufio.Writer
+
+	// systemMetrics is the writer for system_metrics.jsonl
+	systemMetrics *bufio.Writer
+
+	// output is the writer for output.log
+	output *bufio.Writer
+
+	// runConfig is the config built from the run and config records
+	runConfig *runconfig.RunConfig
+
+	// telemetry is the telemetry merged from all records
+	telemetry *service.TelemetryRecord
+
+	// summary is the consolidated summary
+	summary *server.SummaryHandler
+
+	// artifacts is the artifacts index
+	artifacts []Artifact
+}
+
+// Export writes the files described in this package for the transaction
+// log at syncFile into dir, which is created if needed.
+//
+// Corrupt records are skipped and counted, so that whatever can be read
+// from a damaged log is still exported.
+func Export(
+	ctx context.Context,
+	logger *observability.CoreLogger,
+	syncFile string,
+	dir string,
+) (Stats, error) {
+	store := server.NewStore(ctx, syncFile, logger)
+	if err := store.Open(os.O_RDONLY); err != nil {
+		return Stats{}, err
+	}
+	defer store.Close()
+
+	if err := os.MkdirAll(dir, 0755); err != nil {
+		return Stats{}, err
+	}
+
+	e := &exporter{
+		logger:    logger,
+		runConfig: runconfig.New(),
+		telemetry: &service.TelemetryRecord{},
+		summary:   server.NewSummaryHandler(logger, summarystore.Params{}),
+	}
+	defer e.summary.Close()
+
+	var files []*os.File
+	create := func(name string) *bufio.Writer {
+		f, err := os.Create(filepath.Join(dir, name))
+		if err != nil {
+			e.fail(err)
+			return bufio.NewWriter(io.Discard)
+		}
+		files = append(files, f)
+		return bufio.NewWriter(f)
+	}
+	e.history = create(HistoryFileName)
+	e.systemMetrics = create(SystemMetricsFileName)
+	e.output = create(OutputFileName)
+
+	replayStats, err := store.Replay(0, 0, e.handleRecord)
+	e.stats.Records = replayStats.Read
+	e.stats.Corrupt = replayStats.Corrupt
+	if err != nil {
+		e.fail(err)
+	}
+
+	for _, w := range []*bufio.Writer{e.history, e.systemMetrics, e.output} {
+		e.fail(w.Flush())
+	}
+	for _, f := range files {
+		e.fail(f.Close())
+	}
+
+	e.writeSummary(filepath.Join(dir, SummaryFileName))
+	e.writeConfig(filepath.Join(dir, ConfigFileName))
+	e.writeArtifacts(filepath.Join(dir, ArtifactsFileName))
+
+	if e.stats.Corrupt > 0 {
+		logger.Warn(
+			"export: skipped corrupt records",
+			"path", syncFile,
+			"corrupt", e.stats.Corrupt,
+		)
+	}
+	return e.stats, e.err
+}
+
+// fail records the first error.
+func (e *exporter) fail(err error) {
+	if e.err == nil && err != nil {
+		e.err = err
+	}
+}
+
+func (e *exporter) handleRecord(record *service.Record) {
+	switch x := record.RecordType.(type) {
+	case *service.Record_Run:
+		e.handleRun(x.Run)
+	case *service.Record_Config:
+		e.handleConfig(x.Config)
+	case *service.Record_Telemetry:
+		proto.Merge(e.telemetry, x.Telemetry)
+	case *service.Record_History:
+		e.handleHistory(x.History)
+	case *service.Record_Summary:
+		e.summary.UpdateSummary(x.Summary)
+	case *service.Record_Stats:
+		e.handleStats(x.Stats)
+	case *service.Record_Output:
+		e.handleOutput(x.Output.GetLine())
+	case *service.Record_OutputRaw:
+		e.handleOutput(x.OutputRaw.GetLine())
+	case *service.Record_Artifact:
+		e.handleArtifact(x.Artifact)
+	case *service.Record_UseArtifact:
+		e.handleUseArtifact(x.UseArtifact)
+	}
+}
+
+func (e *exporter) handleRun(run *service.RunRecord) {
+	if run.GetConfig() != nil {
+		e.handleConfig(run.GetConfig())
+	}
+	proto.Merge(e.telemetry, run.GetTelemetry())
+	e.summary.UpdateSummary(run.GetSummary())
+}
+
+func (e *exporter) handleConfig(config *service.ConfigRecord) {
+	e.runConfig.ApplyChangeRecord(config, func(err error) {
+		e.logger.CaptureError("export: error updating config", err)
+	})
+}
+
+func (e *exporter) handleHistory(history *service.HistoryRecord) {
+	row, err := corelib.JsonifyItems(history.GetItem())
+	if err != nil {
+		e.logger.CaptureError("export: error converting history", err)
+		return
+	}
+	e.writeLine(e.history, row)
+	e.stats.History++
+}
+
+func (e *exporter) handleStats(stats *service.StatsRecord) {
+	if stats.GetStatsType() != service.StatsRecord_SYSTEM {
+		return
+	}
+
+	items := stats.GetItem()
+	if timestamp := stats.GetTimestamp(); timestamp != nil {
+		seconds := float64(timestamp.AsTime().UnixMicro()) / 1e6
+		items = append(items, &service.StatsItem{
+			Key:       "_timestamp",
+			ValueJson: strconv.FormatFloat(seconds, 'f', -1, 64),
+		})
+	}
+
+	sample, err := corelib.JsonifyItems(items)
+	if err != nil {
+		e.logger.CaptureError("export: error converting system metrics", err)
+		return
+	}
+	e.writeLine(e.systemMetrics, sample)
+	e.stats.SystemMetrics++
+}
+
+func (e *exporter) handleOutput(line string) {
+	// the sender skips empty lines when writing the output file
+	if line == "\n" {
+		return
+	}
+	e.writeLine(e.output, line)
+	e.stats.Output++
+}
+
+func (e *exporter) handleArtifact(artifact *service.ArtifactRecord) {
+	e.artifacts = append(e.artifacts, Artifact{
+		Use:         "logged",
+		Name:        artifact.GetName(),
+		Type:        artifact.GetType(),
+		Digest:      artifact.GetDigest(),
+		Description: artifact.GetDescription(),
+		Metadata:    artifact.GetMetadata(),
+		Aliases:     artifact.GetAliases(),
+	})
+	e.stats.Artifacts++
+}
+
+func (e *exporter) handleUseArtifact(artifact *service.UseArtifactRecord) {
+	e.artifacts = append(e.artifacts, Artifact{
+		Use:  "used",
+		Id:   artifact.GetId(),
+		Name: artifact.GetName(),
+		Type: artifact.GetType(),
+	})
+	e.stats.Artifacts++
+}
+
+func (e *exporter) writeLine(w *bufio.Writer, line string) {
+	_, err := fmt.Fprintln(w, line)
+	e.fail(err)
+}
+
+func (e *exporter) writeSummary(path string) {
+	summary, err := corelib.JsonifyItems(e.summary.Items())
+	if err != nil {
+		e.fail(fmt.Errorf("export: error converting summary: %v", err))
+		return
+	}
+	e.fail(os.WriteFile(path, []byte(summary), 0644))
+}
+
+func (e *exporter) writeConfig(path string) {
+	e.runConfig.AddTelemetryAndMetrics(e.telemetry, nil)
+	config, err := e.runConfig.Serialize(runconfig.FormatYaml)
+	if err != nil {
+		e.fail(fmt.Errorf("export: error converting config: %v", err))
+		return
+	}
+	e.fail(os.WriteFile(path, config, 0644))
+}
+
+func (e *exporter) writeArtifacts(path string) {
+	artifacts := e.artifacts
+	if artifacts == nil {
+		artifacts = []Artifact{}
+	}
+	data, err := json.MarshalIndent(artifacts, "", "  ")
+	if err != nil {
+		e.fail(fmt.Errorf("export: error converting artifacts: %v", err))
+		return
+	}
+	e.fail(os.WriteFile(path, data, 0644))
+}
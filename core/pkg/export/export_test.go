@@ -0,0 +1,151 @@
+package export_test
+
+import (
+	"context"
+	"os"
+	"path/filepath"
+	"strings"
+	"testing"
+	"time"
+
+	"github.com/segmentio/encoding/json"
+	"github.com/stretchr/testify/assert"
+	"google.golang.org/protobuf/types/known/timestamppb"
+
+	"github.com/wandb/wandb/core/pkg/export"
+	"github.com/wandb/wandb/core/pkg/observability"
+	"github.com/wandb/wandb/core/pkg/service"
+	"github.com/wandb/wandb/core/pkg/storetest"
+)
+
+func readFile(t *testing.T, path string) string {
+	data, err := os.ReadFile(path)
+	assert.NoError(t, err)
+	return string(data)
+}
+
+func TestExport(t *testing.T) {
+	dir := t.TempDir()
+	syncFile := filepath.Join(dir, "run-test.wandb")
+	out := filepath.Join(dir, "export")
+
+	storetest.WriteLog(t, syncFile,
+		&service.Record{RecordType: &service.Record_Run{Run: &service.RunRecord{
+			RunId: "test",
+			Config: &service.ConfigRecord{Update: []*service.ConfigItem{
+				{Key: "lr", ValueJson: "0.1"},
+			}},
+		}}},
+		&service.Record{RecordType: &service.Record_Config{Config: &service.ConfigRecord{
+			Update: []*service.ConfigItem{{Key: "epochs", ValueJson: "10"}},
+		}}},
+		&service.Record{RecordType: &service.Record_History{History: &service.HistoryRecord{
+			Item: []*service.HistoryItem{
+				{Key: "_step", ValueJson: "0"},
+				{Key: "loss", ValueJson: "0.5"},
+			},
+		}}},
+		&service.Record{RecordType: &service.Record_History{History: &service.HistoryRecord{
+			Item: []*service.HistoryItem{
+				{Key: "_step", ValueJson: "1"},
+				{Key: "loss", ValueJson: "0.25"},
+			},
+		}}},
+		&service.Record{RecordType: &service.Record_Summary{Summary: &service.SummaryRecord{
+			Update: []*service.SummaryItem{{Key: "loss", ValueJson: "0.5"}},
+		}}},
+		&service.Record{RecordType: &service.Record_Summary{Summary: &service.SummaryRecord{
+			Update: []*service.SummaryItem{{Key: "loss", ValueJson: "0.25"}},
+		}}},
+		&service.Record{RecordType: &service.Record_Stats{Stats: &service.StatsRecord{
+			StatsType: service.StatsRecord_SYSTEM,
+			Timestamp: timestamppb.New(time.Unix(1700000000, 500000000)),
+			Item:      []*service.StatsItem{{Key: "cpu", ValueJson: "12.5"}},
+		}}},
+		&service.Record{RecordType: &service.Record_OutputRaw{OutputRaw: &service.OutputRawRecord{
+			Line: "epoch 1",
+		}}},
+		&service.Record{RecordType: &service.Record_OutputRaw{OutputRaw: &service.OutputRawRecord{
+			Line: "\n",
+		}}},
+		&service.Record{RecordType: &service.Record_Artifact{Artifact: &service.ArtifactRecord{
+			Name:    "model",
+			Type:    "model",
+			Digest:  "abc",
+			Aliases: []string{"latest"},
+		}}},
+		&service.Record{RecordType: &service.Record_UseArtifact{UseArtifact: &service.UseArtifactRecord{
+			Id:   "123",
+			Name: "dataset:v0",
+			Type: "dataset",
+		}}},
+	)
+
+	stats, err := export.Export(context.Background(), observability.NewNoOpLogger(), syncFile, out)
+	assert.NoError(t, err)
+	assert.Equal(t, export.Stats{
+		Records:       11,
+		History:       2,
+		SystemMetrics: 1,
+		Output:        1,
+		Artifacts:     2,
+	}, stats)
+
+	history := strings.Split(readFile(t, filepath.Join(out, export.HistoryFileName)), "\n")
+	assert.Len(t, history, 3)
+	assert.JSONEq(t, `{"_step":0,"loss":0.5}`, history[0])
+	assert.JSONEq(t, `{"_step":1,"loss":0.25}`, history[1])
+	assert.JSONEq(t,
+		`{"loss":0.25}`,
+		readFile(t, filepath.Join(out, export.SummaryFileName)))
+	assert.JSONEq(t,
+		`{"_timestamp":1700000000.5,"cpu":12.5}`,
+		readFile(t, filepath.Join(out, export.SystemMetricsFileName)))
+	assert.Equal(t,
+		"epoch 1\n",
+		readFile(t, filepath.Join(out, export.OutputFileName)))
+
+	config := readFile(t, filepath.Join(out, export.ConfigFileName))
+	assert.Contains(t, config, "lr:\n    value: 0.1\n")
+	assert.Contains(t, config, "epochs:\n    value: 10\n")
+
+	var artifacts []export.Artifact
+	assert.NoError(t, json.Unmarshal(
+		[]byte(readFile(t, filepath.Join(out, export.ArtifactsFileName))),
+		&artifacts,
+	))
+	assert.Equal(t, []export.Artifact{
+		{Use: "logged", Name: "model", Type: "model", Digest: "abc", Aliases: []string{"latest"}},
+		{Use: "used", Id: "123", Name: "dataset:v0", Type: "dataset"},
+	}, artifacts)
+}
+
+func TestExportSummaryNestedKeys(t *testing.T) {
+	dir := t.TempDir()
+	syncFile := filepath.Join(dir, "run-test.wandb")
+	out := filepath.Join(dir, "export")
+
+	storetest.WriteLog(t, syncFile,
+		&service.Record{RecordType: &service.Record_Run{Run: &service.RunRecord{
+			RunId: "test",
+			Summary: &service.SummaryRecord{Update: []*service.SummaryItem{
+				{Key: "eval", ValueJson: `{"acc": 0.5, "f1": 0.4}`},
+			}},
+		}}},
+		&service.Record{RecordType: &service.Record_Summary{Summary: &service.SummaryRecord{
+			Update: []*service.SummaryItem{
+				{NestedKey: []string{"eval", "acc"}, ValueJson: "0.9"},
+				{Key: "loss", ValueJson: "0.25"},
+			},
+			Remove: []*service.SummaryItem{
+				{NestedKey: []string{"eval", "f1"}},
+			},
+		}}},
+	)
+
+	_, err := export.Export(context.Background(), observability.NewNoOpLogger(), syncFile, out)
+	assert.NoError(t, err)
+	assert.JSONEq(t,
+		`{"eval":{"acc":0.9},"loss":0.25}`,
+		readFile(t, filepath.Join(out, export.SummaryFileName)))
+}
@@ -1,7 +1,11 @@
 package filestream
 
 import (
+	"sort"
+	"strings"
 	"time"
+
+	"github.com/segmentio/encoding/json"
 )
 
 type chunkMap map[ChunkTypeEnum][]string
@@ -14,13 +18,59 @@ var chunkFilename = map[ChunkTypeEnum]string{
 }
 
 type chunkCollector struct {
-	input             <-chan processedChunk
-	isDone            bool
-	heartbeatTime     time.Duration
-	delayProcess      time.Duration
-	fileChunks        chunkMap
-	maxItemsPerPush   int
-	itemsCollected    int
+	input           <-chan processedChunk
+	isDone          bool
+	heartbeatTime   time.Duration
+	delayProcess    time.Duration
+	fileChunks      chunkMap
+	maxItemsPerPush int
+	itemsCollected  int
+
+	// fileNames are the files to send chunks in, by default chunkFilename
+	fileNames map[ChunkTypeEnum]string
+
+	// maxBytesPerPush is the budget for the size of the file lines in a
+	// request, or zero for no budget; lines that are larger on their own
+	// are dropped
+	maxBytesPerPush int
+	bytesCollected  int
+
+	// dropped counts all the lines dropped so far, which is what the
+	// server is told
+	dropped int32
+
+	// droppedKeys describes the lines dropped since the last dump, and
+	// lastDroppedKeys those dropped before the last dump, by their file
+	// and keys
+	droppedKeys     []string
+	lastDroppedKeys []string
+
+	// recordNum is the number of the last record whose chunks are all in
+	// the request, or zero
+	recordNum int64
+
+	// carry is a chunk that did not fit into the budget of the previous
+	// request, or that did not continue its output lines, to start the
+	// next one with
+	carry *processedChunk
+
+	// outputStart is the number of the first output line in the request,
+	// whose output lines are consecutive; outputSent is one past the last
+	// output line sent so far
+	outputStart int
+	outputSent  int
+
+	// maxDelayProcess is the longest to wait for more chunks when they
+	// arrive quickly; delay is how long to wait for the next request,
+	// which is delayProcess until it is first adapted
+	maxDelayProcess time.Duration
+	delay           time.Duration
+	isDelayAdapted  bool
+
+	// minDelay is the least time to wait for more chunks, which is the time
+	// until the rate limit allows the next request
+	minDelay time.Duration
+
 	isOverflow        bool
 	isTransmitReady   bool
 	isDirty           bool
@@ -31,13 +81,20 @@ type chunkCollector struct {
 func (cr *chunkCollector) reset() {
 	cr.fileChunks = make(chunkMap)
 	cr.itemsCollected = 0
+	cr.bytesCollected = 0
 	cr.transmitData = &FsTransmitData{}
+	cr.recordNum = 0
 	cr.isTransmitReady = false
 	cr.isDirty = false
 }
 
 func (cr *chunkCollector) read() bool {
 	cr.reset()
+	if cr.carry != nil {
+		cr.addFileChunk(*cr.carry)
+		cr.carry = nil
+		return true
+	}
 	select {
 	case chunk, ok := <-cr.input:
 		if !ok {
@@ -52,7 +109,7 @@ func (cr *chunkCollector) read() bool {
 }
 
 func (cr *chunkCollector) delayTime() time.Duration {
-	delayTime := cr.delayProcess
+	delayTime := cr.currentDelay()
 	// do not delay for more chunks if we overflowed on last iteration
 	if cr.isOverflow {
 		delayTime = 0
@@ -62,8 +119,11 @@ func (cr *chunkCollector) delayTime() time.Duration {
 }
 
 func (cr *chunkCollector) readMore() {
-	// TODO(core:beta): add rate limiting
-	delayChan := time.After(cr.delayTime())
+	defer cr.adaptDelay()
+
+	// there is no point in sending before the rate limit allows it, so the
+	// chunks that arrive until then are sent together
+	delayChan := time.After(max(cr.delayTime(), cr.minDelay))
 	for {
 		select {
 		case chunk, ok := <-cr.input:
@@ -71,6 +131,11 @@ func (cr *chunkCollector) readMore() {
 				cr.isDone = true
 				return
 			}
+			if cr.exceedsBudget(chunk) || !cr.continuesOutput(chunk) {
+				cr.carry = &chunk
+				cr.isOverflow = true
+				return
+			}
 			cr.addFileChunk(chunk)
 			if cr.itemsCollected >= cr.maxItemsPerPush {
 				cr.isOverflow = true
@@ -82,6 +147,41 @@ func (cr *chunkCollector) readMore() {
 	}
 }
 
+// busyWindowItems is the number of chunks collected in a window above which
+// the next window is made longer.
+const busyWindowItems = 100
+
+// adaptDelay sets how long to wait for more chunks next time from how many
+// arrived this time.
+//
+// When chunks arrive quickly, waiting longer makes for fewer and larger
+// requests. When nothing arrives while waiting, waiting only adds latency,
+// so the next chunk is sent right away.
+func (cr *chunkCollector) adaptDelay() {
+	delay := cr.currentDelay()
+	switch {
+	case cr.itemsCollected <= 1:
+		delay = 0
+	case delay == 0:
+		delay = cr.delayProcess
+	case cr.itemsCollected >= busyWindowItems:
+		delay = min(2*delay, max(cr.maxDelayProcess, cr.delayProcess))
+	default:
+		delay = max(delay/2, cr.delayProcess)
+	}
+	cr.delay = delay
+	cr.isDelayAdapted = true
+}
+
+// currentDelay returns how long to wait for more chunks, not counting
+// overflows.
+func (cr *chunkCollector) currentDelay() time.Duration {
+	if cr.isDelayAdapted {
+		return cr.delay
+	}
+	return cr.delayProcess
+}
+
 func (cr *chunkCollector) update(chunk processedChunk) {
 	// Complete and Exitcode are saved to finalTransmitData because
 	// they need to be sent last
@@ -107,9 +207,73 @@ func (cr *chunkCollector) update(chunk processedChunk) {
 	}
 }
 
+// exceedsBudget returns whether adding the chunk would make the request
+// larger than the byte budget.
+//
+// A chunk that is too large for any request does not count, since it is
+// dropped when added.
+func (cr *chunkCollector) exceedsBudget(chunk processedChunk) bool {
+	if cr.maxBytesPerPush <= 0 || chunk.fileType == NoneChunk {
+		return false
+	}
+	size := encodedSize(chunk.fileLine)
+	return size <= cr.maxBytesPerPush &&
+		cr.bytesCollected+size > cr.maxBytesPerPush
+}
+
+// continuesOutput returns whether a chunk can go into the request with the
+// output lines collected so far, which is when it is not an output line or
+// when it rewrites one of them or follows them.
+func (cr *chunkCollector) continuesOutput(chunk processedChunk) bool {
+	lines := cr.fileChunks[OutputChunk]
+	if chunk.fileType != OutputChunk || len(lines) == 0 {
+		return true
+	}
+	i := chunk.outputLine - cr.outputStart
+	return i >= 0 && i <= len(lines)
+}
+
 func (cr *chunkCollector) addFileChunk(chunk processedChunk) {
+	if chunk.recordNum > 0 {
+		cr.recordNum = chunk.recordNum
+		return
+	}
 	if chunk.fileType != NoneChunk {
+		size := encodedSize(chunk.fileLine)
+		if cr.maxBytesPerPush > 0 && size > cr.maxBytesPerPush {
+			// the server rejects requests this large
+			cr.dropped++
+			dropped := cr.fileName(chunk.fileType)
+			if keys := lineKeys(chunk.fileLine); len(keys) > 0 {
+				dropped += ": " + strings.Join(keys, ",")
+			}
+			cr.droppedKeys = append(cr.droppedKeys, dropped)
+			cr.isDirty = true
+			return
+		}
+		if chunk.fileType == SummaryChunk && len(cr.fileChunks[SummaryChunk]) > 0 {
+			// every summary line has the whole summary, so only the newest
+			// one needs to be sent
+			cr.bytesCollected -= encodedSize(cr.fileChunks[SummaryChunk][0])
+			cr.fileChunks[SummaryChunk][0] = chunk.fileLine
+			cr.bytesCollected += size
+			cr.isDirty = true
+			return
+		}
+		if chunk.fileType == OutputChunk {
+			lines := cr.fileChunks[OutputChunk]
+			if len(lines) == 0 {
+				cr.outputStart = chunk.outputLine
+			} else if i := chunk.outputLine - cr.outputStart; i < len(lines) {
+				// a line rewritten before it was sent is sent once
+				cr.bytesCollected += size - encodedSize(lines[i])
+				lines[i] = chunk.fileLine
+				cr.isDirty = true
+				return
+			}
+		}
 		cr.fileChunks[chunk.fileType] = append(cr.fileChunks[chunk.fileType], chunk.fileLine)
+		cr.bytesCollected += size
 		cr.isDirty = true
 	} else {
 		cr.update(chunk)
@@ -130,21 +294,52 @@ func (cr *chunkCollector) dumpFinalTransmit() {
 	}
 }
 
+// fileName returns the file that chunks of a type are sent in.
+func (cr *chunkCollector) fileName(fileType ChunkTypeEnum) string {
+	if cr.fileNames != nil {
+		return cr.fileNames[fileType]
+	}
+	return chunkFilename[fileType]
+}
+
+// lineKeys returns the sorted keys of a line that is a JSON object, such
+// as a history row, to tell which values made it too large.
+func lineKeys(line string) []string {
+	var row map[string]json.RawMessage
+	if err := json.Unmarshal([]byte(line), &row); err != nil {
+		return nil
+	}
+	keys := make([]string, 0, len(row))
+	for key := range row {
+		keys = append(keys, key)
+	}
+	sort.Strings(keys)
+	return keys
+}
+
 func (cr *chunkCollector) dump(offsets FileStreamOffsetMap) *FsTransmitData {
+	cr.lastDroppedKeys = nil
 	if cr.isDirty {
 		files := make(map[string]fsTransmitFileData)
 		for fileType, lines := range cr.fileChunks {
-			fname := chunkFilename[fileType]
+			fname := cr.fileName(fileType)
+			if fileType == OutputChunk {
+				files[fname] = cr.dumpOutput(offsets, lines)
+				continue
+			}
 			files[fname] = fsTransmitFileData{
 				Offset:  offsets[fileType],
 				Content: lines}
 			offsets[fileType] += len(lines)
 		}
 		cr.transmitData.Files = files
+		cr.transmitData.Dropped = cr.dropped
+		cr.lastDroppedKeys, cr.droppedKeys = cr.droppedKeys, nil
 		cr.isTransmitReady = true
 		cr.isDirty = false
 	}
-	if cr.isDone {
+	// the final data is sent last, after any carried chunk
+	if cr.isDone && cr.carry == nil {
 		cr.dumpFinalTransmit()
 	}
 	if cr.isTransmitReady {
@@ -152,3 +347,41 @@ func (cr *chunkCollector) dump(offsets FileStreamOffsetMap) *FsTransmitData {
 	}
 	return nil
 }
+
+// dumpOutput returns the output lines of the request.
+//
+// The offset of the output file is where the next new line goes, so the
+// lines go at that offset minus the number of lines between them and the
+// next new line. Lines before the offset replace the lines there.
+func (cr *chunkCollector) dumpOutput(
+	offsets FileStreamOffsetMap,
+	lines []string,
+) fsTransmitFileData {
+	data := fsTransmitFileData{
+		Offset:  offsets[OutputChunk] - (cr.outputSent - cr.outputStart),
+		Content: lines,
+	}
+	end := cr.outputStart + len(lines)
+	if end > cr.outputSent {
+		offsets[OutputChunk] += end - cr.outputSent
+		cr.outputSent = end
+	}
+	return data
+}
+
+// encodedSize returns the size of a line once encoded as a JSON string in a
+// list, assuming the worst case for characters that may be escaped.
+func encodedSize(line string) int {
+	size := len(`"",`)
+	for i := 0; i < len(line); i++ {
+		switch c := line[i]; {
+		case c == '"' || c == '\\':
+			size += 2
+		case c < 0x20 || c == '<' || c == '>' || c == '&':
+			size += len(`\u0000`)
+		default:
+			size++
+		}
+	}
+	return size
+}
@@ -1,6 +1,7 @@
 package filestream
 
 import (
+	"strings"
 	"testing"
 	"time"
 
@@ -156,3 +157,189 @@ func TestIsDirtyResetAfterDump(t *testing.T) {
 	collector.dump(offset)
 	assert.False(t, collector.isDirty)
 }
+
+func TestCollectByteBudget(t *testing.T) {
+	input := make(chan processedChunk, 32)
+	input <- processedChunk{fileType: HistoryChunk, fileLine: "aaaa"}
+	input <- processedChunk{fileType: HistoryChunk, fileLine: "bbbb"}
+	input <- processedChunk{fileType: HistoryChunk, fileLine: "cccc"}
+	close(input)
+	collector := chunkCollector{
+		input:           input,
+		heartbeatTime:   60 * time.Second,
+		delayProcess:    30 * time.Second,
+		maxItemsPerPush: 100,
+		// two lines of 4 bytes, quoted and followed by a comma
+		maxBytesPerPush: 2 * encodedSize("aaaa"),
+	}
+	offset := FileStreamOffsetMap{}
+
+	assert.True(t, collector.read())
+	collector.readMore()
+	assert.Equal(t,
+		[]string{"aaaa", "bbbb"},
+		collector.dump(offset).Files[HistoryFileName].Content)
+
+	// the line that did not fit is sent next
+	assert.True(t, collector.read())
+	collector.readMore()
+	data := collector.dump(offset)
+	assert.Equal(t, 2, data.Files[HistoryFileName].Offset)
+	assert.Equal(t, []string{"cccc"}, data.Files[HistoryFileName].Content)
+
+	if !collector.isDone {
+		assert.False(t, collector.read())
+	}
+	assert.True(t, collector.isDone)
+}
+
+func TestCollectDropsOversizedLines(t *testing.T) {
+	input := make(chan processedChunk, 32)
+	input <- processedChunk{fileType: HistoryChunk, fileLine: "small"}
+	input <- processedChunk{
+		fileType: HistoryChunk,
+		fileLine: `{"big": "` + strings.Repeat("x", 100) + `", "_step": 1}`,
+	}
+	input <- processedChunk{fileType: EventsChunk, fileLine: strings.Repeat("y", 100)}
+	input <- processedChunk{fileType: HistoryChunk, fileLine: "small2"}
+	exitcode := int32(0)
+	input <- processedChunk{Exitcode: &exitcode}
+	close(input)
+	collector := chunkCollector{
+		input:           input,
+		heartbeatTime:   60 * time.Second,
+		delayProcess:    30 * time.Second,
+		maxItemsPerPush: 100,
+		maxBytesPerPush: 50,
+	}
+
+	assert.True(t, collector.read())
+	collector.readMore()
+	assert.Equal(t,
+		&FsTransmitData{
+			Files: map[string]fsTransmitFileData{
+				"wandb-history.jsonl": {
+					Offset:  0,
+					Content: []string{"small", "small2"},
+				},
+			},
+			Exitcode: &exitcode,
+			Dropped:  2,
+		},
+		collector.dump(FileStreamOffsetMap{}),
+	)
+	assert.Equal(t,
+		[]string{"wandb-history.jsonl: _step,big", "wandb-events.jsonl"},
+		collector.lastDroppedKeys)
+
+	// the server is told all the lines dropped so far
+	collector.addFileChunk(processedChunk{fileType: HistoryChunk, fileLine: strings.Repeat("z", 100)})
+	assert.Equal(t, int32(3), collector.dump(FileStreamOffsetMap{}).Dropped)
+}
+
+func TestEncodedSize(t *testing.T) {
+	assert.Equal(t, len(`"abc",`), encodedSize("abc"))
+	assert.Equal(t, len(`"{\"a\":1}",`), encodedSize(`{"a":1}`))
+	assert.Equal(t, len(`"\u003c\u000a",`), encodedSize("<\n"))
+}
+
+func TestCollectKeepsNewestSummary(t *testing.T) {
+	input := make(chan processedChunk, 32)
+	input <- processedChunk{fileType: SummaryChunk, fileLine: `{"loss":1}`}
+	input <- processedChunk{fileType: HistoryChunk, fileLine: `{"loss":1}`}
+	input <- processedChunk{fileType: SummaryChunk, fileLine: `{"loss":0.5}`}
+	input <- processedChunk{fileType: HistoryChunk, fileLine: `{"loss":0.5}`}
+	collector := chunkCollector{
+		input:           input,
+		heartbeatTime:   60 * time.Second,
+		delayProcess:    10 * time.Millisecond,
+		maxItemsPerPush: 100,
+	}
+	assert.True(t, collector.read())
+	collector.readMore()
+	assert.Equal(t,
+		map[string]fsTransmitFileData{
+			"wandb-history.jsonl": {
+				Offset:  0,
+				Content: []string{`{"loss":1}`, `{"loss":0.5}`},
+			},
+			"wandb-summary.json": {
+				Offset:  0,
+				Content: []string{`{"loss":0.5}`},
+			},
+		},
+		collector.dump(FileStreamOffsetMap{}).Files,
+	)
+}
+
+func TestAdaptDelay(t *testing.T) {
+	collector := chunkCollector{
+		delayProcess:    10 * time.Millisecond,
+		maxDelayProcess: 40 * time.Millisecond,
+	}
+	assert.Equal(t, 10*time.Millisecond, collector.currentDelay())
+
+	for _, step := range []struct {
+		items int
+		delay time.Duration
+	}{
+		// nothing arrived while waiting, so flush right away next time
+		{1, 0},
+		{5, 10 * time.Millisecond},
+		// busy, so wait longer up to the limit
+		{200, 20 * time.Millisecond},
+		{200, 40 * time.Millisecond},
+		{200, 40 * time.Millisecond},
+		// quieter again
+		{5, 20 * time.Millisecond},
+		{5, 10 * time.Millisecond},
+		{1, 0},
+	} {
+		collector.itemsCollected = step.items
+		collector.adaptDelay()
+		assert.Equal(t, step.delay, collector.currentDelay())
+	}
+}
+
+func TestCollectRewrittenOutput(t *testing.T) {
+	input := make(chan processedChunk, 32)
+	output := func(num int, line string) processedChunk {
+		return processedChunk{fileType: OutputChunk, fileLine: line, outputLine: num}
+	}
+	collector := chunkCollector{
+		input:           input,
+		heartbeatTime:   60 * time.Second,
+		delayProcess:    time.Millisecond,
+		maxItemsPerPush: 100,
+	}
+	offsets := FileStreamOffsetMap{OutputChunk: 10}
+	nextOutput := func() fsTransmitFileData {
+		assert.True(t, collector.read())
+		collector.readMore()
+		return collector.dump(offsets).Files[OutputFileName]
+	}
+
+	// a line rewritten before it is sent is sent once
+	input <- output(0, "a")
+	input <- output(1, "b 10%")
+	input <- output(1, "b 50%")
+	assert.Equal(t,
+		fsTransmitFileData{Offset: 10, Content: []string{"a", "b 50%"}},
+		nextOutput())
+
+	// lines that do not follow the lines collected go in the next request
+	input <- output(2, "c")
+	input <- output(0, "A")
+	input <- output(2, "c 100%")
+	close(input)
+	assert.Equal(t,
+		fsTransmitFileData{Offset: 12, Content: []string{"c"}},
+		nextOutput())
+	assert.Equal(t,
+		fsTransmitFileData{Offset: 10, Content: []string{"A"}},
+		nextOutput())
+	assert.Equal(t,
+		fsTransmitFileData{Offset: 12, Content: []string{"c 100%"}},
+		nextOutput())
+	assert.Equal(t, 13, offsets[OutputChunk])
+}
@@ -0,0 +1,73 @@
+package filestream
+
+import (
+	"bytes"
+	"compress/gzip"
+	"io"
+	"net/http"
+
+	"github.com/wandb/wandb/core/internal/api"
+)
+
+// newRequest returns the request to send the JSON data to the filestream
+// service, compressed if the filestream compresses requests.
+func (fs *fileStream) newRequest(jsonData []byte) *api.Request {
+	req := &api.Request{
+		Method: http.MethodPost,
+		Path:   fs.path,
+		Body:   jsonData,
+		Headers: map[string]string{
+			"Content-Type": "application/json",
+		},
+	}
+
+	if !fs.gzipRequests {
+		return req
+	}
+	compressed, err := gzipBytes(jsonData)
+	if err != nil {
+		fs.logger.CaptureError("filestream: failed to compress request", err)
+		return req
+	}
+	req.Body = compressed
+	req.Headers["Content-Encoding"] = "gzip"
+	return req
+}
+
+// isCompressionRejected returns whether the server refused a request
+// because it was compressed.
+//
+// Servers that do not accept compressed bodies reply with 415 Unsupported
+// Media Type, see RFC 7694, or fail to parse them and reply with 400 Bad
+// Request. After that, requests are sent uncompressed.
+func (fs *fileStream) isCompressionRejected(req *api.Request, resp *http.Response) bool {
+	if req.Headers["Content-Encoding"] == "" ||
+		(resp.StatusCode != http.StatusUnsupportedMediaType &&
+			resp.StatusCode != http.StatusBadRequest) {
+		return false
+	}
+
+	fs.logger.Warn(
+		"filestream: server rejected a compressed request, sending requests uncompressed",
+		"status", resp.StatusCode,
+		"accept_encoding", resp.Header.Get("Accept-Encoding"),
+	)
+	fs.gzipRequests = false
+	_, _ = io.Copy(io.Discard, resp.Body)
+	if err := resp.Body.Close(); err != nil {
+		fs.logger.CaptureError("filestream: error closing response body", err)
+	}
+	return true
+}
+
+func gzipBytes(data []byte) ([]byte, error) {
+	var buf bytes.Buffer
+	w := gzip.NewWriter(&buf)
+	if _, err := w.Write(data); err != nil {
+		return nil, err
+	}
+	if err := w.Close(); err != nil {
+		return nil, err
+	}
+	return buf.Bytes(), nil
+}
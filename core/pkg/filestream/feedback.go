@@ -0,0 +1,69 @@
+package filestream
+
+import (
+	"net/http"
+	"strconv"
+	"time"
+)
+
+// Feedback is what the filestream service tells us in its replies.
+//
+// A reply is a JSON object; the fields that are understood are:
+//
+//	{
+//	  "stopped": true,                     // the run was stopped remotely
+//	  "limits": {"heartbeat_seconds": 30}
+//	}
+//
+// A Retry-After header with a number of seconds asks the filestream to
+// wait before sending more data. Other fields are ignored.
+type Feedback struct {
+	// StopRun is whether the run was stopped, for example from the UI
+	StopRun bool
+
+	// RetryAfter is how long the server wants us to wait before sending
+	// more data, zero if we are not throttled
+	RetryAfter time.Duration
+
+	// Limits are the server-side limits, if the server sent any
+	Limits *FeedbackLimits
+}
+
+// FeedbackLimits are server-side limits for the filestream.
+//
+// Zero values mean that the server did not set the limit.
+type FeedbackLimits struct {
+	// HeartbeatInterval is how often to send a request when there is no data
+	HeartbeatInterval time.Duration
+}
+
+// IsEmpty returns whether the feedback asks for nothing.
+func (f *Feedback) IsEmpty() bool {
+	return !f.StopRun && f.RetryAfter == 0 && f.Limits == nil
+}
+
+// parseFeedback reads the feedback from a decoded reply and its headers.
+//
+// Fields of the wrong type are ignored, so that the server can extend its
+// replies without breaking older clients.
+func parseFeedback(reply map[string]interface{}, header http.Header) Feedback {
+	feedback := Feedback{}
+
+	if stopped, ok := reply["stopped"].(bool); ok {
+		feedback.StopRun = stopped
+	}
+
+	if seconds, err := strconv.Atoi(header.Get("Retry-After")); err == nil && seconds > 0 {
+		feedback.RetryAfter = time.Duration(seconds) * time.Second
+	}
+
+	if limits, ok := reply["limits"].(map[string]interface{}); ok {
+		if seconds, ok := limits["heartbeat_seconds"].(float64); ok && seconds > 0 {
+			feedback.Limits = &FeedbackLimits{
+				HeartbeatInterval: time.Duration(seconds * float64(time.Second)),
+			}
+		}
+	}
+
+	return feedback
+}
@@ -7,6 +7,8 @@
 //	transmit: collect and transmit messages to the filestream service
 //	feedback: process feedback from the filestream service
 //
+// and one more for each [Sink] that mirrors the data sent to the service.
+//
 // Below demonstrates a common execution flow through this package:
 //
 //	{caller}:
@@ -41,15 +43,24 @@ import (
 )
 
 const (
-	BufferSize               = 32
-	EventsFileName           = "wandb-events.jsonl"
-	HistoryFileName          = "wandb-history.jsonl"
-	SummaryFileName          = "wandb-summary.json"
-	OutputFileName           = "output.log"
-	defaultMaxItemsPerPush   = 5_000
-	defaultDelayProcess      = 20 * time.Millisecond
-	defaultPollInterval      = 2 * time.Second
-	defaultHeartbeatInterval = 30 * time.Second
+	BufferSize             = 32
+	EventsFileName         = "wandb-events.jsonl"
+	HistoryFileName        = "wandb-history.jsonl"
+	SummaryFileName        = "wandb-summary.json"
+	OutputFileName         = "output.log"
+	defaultMaxItemsPerPush = 5_000
+	// the backend rejects requests larger than about 10MiB
+	defaultMaxBytesPerPush    = (10 << 20) - (100 << 10)
+	defaultDelayProcess       = 20 * time.Millisecond
+	defaultMaxDelayProcess    = time.Second
+	defaultPollInterval       = 2 * time.Second
+	defaultHeartbeatInterval  = 30 * time.Second
+	defaultSpillRetryInterval = 10 * time.Second
+
+	// maxSpillAttempts is how many times the first of the requests that
+	// could not be sent is retried before it is given up on, so that one
+	// request cannot hold back the rest forever
+	maxSpillAttempts = 100
 )
 
 type ChunkTypeEnum int8
@@ -63,6 +74,16 @@ const (
 	SummaryChunk
 )
 
+// OutputLine is a line of a run's console output.
+type OutputLine struct {
+	// Num is the number of the line, counting from the first line of
+	// output streamed.
+	Num int
+
+	// Content is the text of the line.
+	Content string
+}
+
 type FileStream interface {
 	// Start creates internal goroutines without blocking.
 	Start()
@@ -73,6 +94,13 @@ type FileStream interface {
 	// StreamRecord adds data to be sent to the filestream API.
 	StreamRecord(rec *service.Record)
 
+	// StreamOutput adds lines of the run's console output.
+	//
+	// A line streamed again replaces the earlier one, which is how lines
+	// rewritten by progress bars are updated. Output records passed to
+	// StreamRecord are numbered after the lines streamed before them.
+	StreamOutput(lines []OutputLine)
+
 	// SignalFileUploaded tells the backend that a run file has been uploaded.
 	//
 	// This is used in some deployments where the backend is not notified when
@@ -85,6 +113,28 @@ type FileStream interface {
 	// SetOffsets sets the per-chunk offsets to stream to.
 	SetOffsets(offsetMap FileStreamOffsetMap)
 
+	// SetFeedbackHandler sets a function to call with the feedback from
+	// the filestream service.
+	//
+	// It must be called before Start. The function is called from a
+	// separate goroutine.
+	SetFeedbackHandler(handler func(Feedback))
+
+	// SetDeliveredHandler sets a function to call with the number of the
+	// last record whose data the filestream service accepted.
+	//
+	// It must be called before Start. The function is called from a
+	// separate goroutine.
+	SetDeliveredHandler(handler func(num int64))
+
+	// SetErrorHandler sets a function to call with the errors that lose
+	// data: requests the filestream service rejected, and requests that
+	// were still not sent when the filestream closed.
+	//
+	// It must be called before Start. The function is called from a
+	// separate goroutine.
+	SetErrorHandler(handler func(err error))
+
 	// GetLastTransmitTime returns the last time we sent data to the server.
 	GetLastTransmitTime() time.Time
 }
@@ -98,15 +148,38 @@ type fileStream struct {
 
 	processChan  chan processTask
 	transmitChan chan processedChunk
-	feedbackChan chan map[string]interface{}
+	feedbackChan chan Feedback
 
 	processWait  *sync.WaitGroup
 	transmitWait *sync.WaitGroup
 	feedbackWait *sync.WaitGroup
 
+	// closing is closed when Close is called
+	closing chan struct{}
+
 	// keep track of where we are streaming each file chunk
 	offsetMap FileStreamOffsetMap
 
+	// nextOutputLine is the number of the next new line of output, owned
+	// by the process loop
+	nextOutputLine int
+
+	// feedbackHandler is called with the feedback from the server
+	feedbackHandler func(Feedback)
+
+	// deliveredHandler is called with the number of the last record whose
+	// data was sent, and spilledNum is the number of the last record with
+	// data in the requests that could not be sent yet
+	deliveredHandler func(num int64)
+	spilledNum       int64
+
+	// errorHandler is called with the errors that lose data
+	errorHandler func(err error)
+
+	// pendingFeedback is the feedback not yet applied to the transmit loop
+	pendingFeedback Feedback
+	feedbackMu      sync.Mutex
+
 	// settings is the settings for the filestream
 	settings *service.Settings
 
@@ -117,7 +190,9 @@ type fileStream struct {
 	apiClient api.Client
 
 	maxItemsPerPush int
+	maxBytesPerPush int
 	delayProcess    time.Duration
+	maxDelayProcess time.Duration
 	pollInterval    time.Duration
 
 	// lastTransmitTime is the last time we sent data to the server
@@ -128,7 +203,35 @@ type fileStream struct {
 	lastTransmitTime  time.Time
 	heartbeatInterval time.Duration
 
+	// spill has the requests that could not be sent, which are sent again
+	// every spillRetryInterval
+	spill              *spillQueue
+	spillRetryInterval time.Duration
+	lastSpillRetry     time.Time
+
+	// spillFailures is how many times in a row sending the first request
+	// of the spill queue failed
+	spillFailures int
+
+	// gzipRequests is whether to compress requests, until the server
+	// rejects a compressed request
+	gzipRequests bool
+
+	// rateLimit is how often requests may be sent, as the server allows
+	rateLimit *rateLimit
+
+	// networkStatus receives messages for the user about network issues
+	networkStatus *observability.Peeker
+
+	// sinks get the same data as the filestream service
+	sinks []*sinkStream
+
+	// clientId identifies this writer of a shared run, empty otherwise
 	clientId string
+
+	// fileNames are the files to send chunks in, which are particular to
+	// this writer for some chunks of shared runs
+	fileNames map[ChunkTypeEnum]string
 }
 
 type FileStreamParams struct {
@@ -136,44 +239,82 @@ type FileStreamParams struct {
 	Logger            *observability.CoreLogger
 	ApiClient         api.Client
 	MaxItemsPerPush   int
+	MaxBytesPerPush   int
 	ClientId          string
 	DelayProcess      time.Duration
+	MaxDelayProcess   time.Duration
 	PollInterval      time.Duration
 	LastTransmitTime  time.Time
 	HeartbeatInterval time.Duration
+
+	// SpillDir is the directory in which to keep requests that could not
+	// be sent; they are kept in memory if it is empty
+	SpillDir string
+
+	// SpillRetryInterval is how often to retry requests that could not
+	// be sent
+	SpillRetryInterval time.Duration
+
+	// NetworkStatus receives messages for the user about network issues
+	NetworkStatus *observability.Peeker
+
+	// GzipRequests is whether to compress requests; they are sent
+	// uncompressed if the server does not accept compressed requests
+	GzipRequests bool
+
+	// Sinks are other destinations for the data sent to the filestream
+	// service, such as a local copy of the run's files
+	Sinks []Sink
 }
 
 func NewFileStream(params FileStreamParams) FileStream {
 	fs := &fileStream{
-		settings:          params.Settings,
-		logger:            params.Logger,
-		apiClient:         params.ApiClient,
-		processWait:       &sync.WaitGroup{},
-		transmitWait:      &sync.WaitGroup{},
-		feedbackWait:      &sync.WaitGroup{},
-		processChan:       make(chan processTask, BufferSize),
-		transmitChan:      make(chan processedChunk, BufferSize),
-		feedbackChan:      make(chan map[string]interface{}, BufferSize),
-		offsetMap:         make(FileStreamOffsetMap),
-		maxItemsPerPush:   defaultMaxItemsPerPush,
-		delayProcess:      defaultDelayProcess,
-		pollInterval:      defaultPollInterval,
-		lastTransmitTime:  time.Now(),
-		heartbeatInterval: defaultHeartbeatInterval,
+		settings:           params.Settings,
+		logger:             params.Logger,
+		apiClient:          params.ApiClient,
+		processWait:        &sync.WaitGroup{},
+		transmitWait:       &sync.WaitGroup{},
+		feedbackWait:       &sync.WaitGroup{},
+		closing:            make(chan struct{}),
+		processChan:        make(chan processTask, BufferSize),
+		transmitChan:       make(chan processedChunk, BufferSize),
+		feedbackChan:       make(chan Feedback, BufferSize),
+		offsetMap:          make(FileStreamOffsetMap),
+		maxItemsPerPush:    defaultMaxItemsPerPush,
+		maxBytesPerPush:    defaultMaxBytesPerPush,
+		delayProcess:       defaultDelayProcess,
+		maxDelayProcess:    defaultMaxDelayProcess,
+		pollInterval:       defaultPollInterval,
+		lastTransmitTime:   time.Now(),
+		heartbeatInterval:  defaultHeartbeatInterval,
+		spill:              newSpillQueue(params.SpillDir),
+		spillRetryInterval: defaultSpillRetryInterval,
+		networkStatus:      params.NetworkStatus,
+		gzipRequests:       params.GzipRequests,
+		rateLimit:          newRateLimit(),
 	}
 
 	if params.MaxItemsPerPush > 0 {
 		fs.maxItemsPerPush = params.MaxItemsPerPush
 	}
+	if params.MaxBytesPerPush > 0 {
+		fs.maxBytesPerPush = params.MaxBytesPerPush
+	}
 	if params.DelayProcess > 0 {
 		fs.delayProcess = params.DelayProcess
 	}
+	if params.MaxDelayProcess > 0 {
+		fs.maxDelayProcess = params.MaxDelayProcess
+	}
 	if params.PollInterval > 0 {
 		fs.pollInterval = params.PollInterval
 	}
 	if params.HeartbeatInterval > 0 {
 		fs.heartbeatInterval = params.HeartbeatInterval
 	}
+	if params.SpillRetryInterval > 0 {
+		fs.spillRetryInterval = params.SpillRetryInterval
+	}
 	if !params.LastTransmitTime.IsZero() {
 		fs.lastTransmitTime = params.LastTransmitTime
 	}
@@ -181,26 +322,52 @@ func NewFileStream(params FileStreamParams) FileStream {
 	if fs.settings.GetXShared().GetValue() && params.ClientId != "" {
 		fs.clientId = params.ClientId
 	}
+	fs.fileNames = fileNamesFor(fs.clientId)
+	for i, sink := range params.Sinks {
+		fs.sinks = append(fs.sinks, fs.newSinkStream(i, sink))
+	}
 
 	return fs
 }
 
 func (fs *fileStream) SetPath(path string) {
 	fs.path = path
+	for _, ss := range fs.sinks {
+		if sink, ok := ss.sink.(pathSetter); ok {
+			sink.SetPath(path)
+		}
+	}
 }
 
 func (fs *fileStream) SetOffsets(offsetMap FileStreamOffsetMap) {
 	for k, v := range offsetMap {
+		// the files of this writer of a shared run start out empty
+		if fs.fileNames[k] != chunkFilename[k] {
+			continue
+		}
 		fs.offsetMap[k] = v
 	}
 }
 
+func (fs *fileStream) SetFeedbackHandler(handler func(Feedback)) {
+	fs.feedbackHandler = handler
+}
+
+func (fs *fileStream) SetDeliveredHandler(handler func(num int64)) {
+	fs.deliveredHandler = handler
+}
+
+func (fs *fileStream) SetErrorHandler(handler func(err error)) {
+	fs.errorHandler = handler
+}
+
 func (fs *fileStream) GetLastTransmitTime() time.Time {
 	return fs.lastTransmitTime
 }
 
 func (fs *fileStream) Start() {
 	fs.logger.Debug("filestream: start", "path", fs.path)
+	fs.checkSpilled()
 
 	fs.processWait.Add(1)
 	go func() {
@@ -219,6 +386,10 @@ func (fs *fileStream) Start() {
 		fs.loopFeedback(fs.feedbackChan)
 		fs.feedbackWait.Done()
 	}()
+
+	for _, sink := range fs.sinks {
+		sink.start()
+	}
 }
 
 func (fs *fileStream) StreamRecord(rec *service.Record) {
@@ -226,16 +397,82 @@ func (fs *fileStream) StreamRecord(rec *service.Record) {
 	fs.addProcess(processTask{Record: rec})
 }
 
+func (fs *fileStream) StreamOutput(lines []OutputLine) {
+	if len(lines) == 0 {
+		return
+	}
+	fs.addProcess(processTask{OutputLines: lines})
+}
+
+// checkSpilled drops the requests left over by an earlier process unless
+// they are for this run and follow the lines the server has.
+//
+// The lines of the run go after the requests that are kept.
+func (fs *fileStream) checkSpilled() {
+	if fs.spill.len == 0 {
+		return
+	}
+
+	header := fs.spill.header
+	switch {
+	case header == nil:
+		fs.logger.CaptureWarn(
+			"filestream: dropping requests left over for an unknown run",
+			"requests", fs.spill.len,
+		)
+	case header.Path != fs.path:
+		fs.logger.CaptureWarn(
+			"filestream: dropping requests left over for another run",
+			"requests", fs.spill.len,
+			"path", header.Path,
+		)
+	case !fs.followsOffsets(header.Offsets):
+		fs.logger.CaptureWarn(
+			"filestream: dropping requests left over at other offsets",
+			"requests", fs.spill.len,
+			"offsets", header.Offsets,
+		)
+	default:
+		offsets, err := fs.spill.offsetsAfter()
+		if err == nil {
+			for fileType, name := range fs.fileNames {
+				fs.offsetMap[fileType] = offsets[name]
+			}
+			return
+		}
+		fs.logger.CaptureError("filestream: dropping requests left over", err)
+	}
+
+	if err := fs.spill.store(nil); err != nil {
+		fs.logger.CaptureError("filestream: failed to drop requests", err)
+	}
+}
+
+// followsOffsets returns whether the offsets of the run's files, by file
+// name, are where the stream starts.
+func (fs *fileStream) followsOffsets(offsets map[string]int) bool {
+	for fileType, name := range fs.fileNames {
+		if offsets[name] != fs.offsetMap[fileType] {
+			return false
+		}
+	}
+	return true
+}
+
 func (fs *fileStream) SignalFileUploaded(path string) {
 	fs.addProcess(processTask{UploadedFile: path})
 }
 
 func (fs *fileStream) Close() {
+	close(fs.closing)
 	close(fs.processChan)
 	fs.processWait.Wait()
 	close(fs.transmitChan)
 	fs.transmitWait.Wait()
 	close(fs.feedbackChan)
 	fs.feedbackWait.Wait()
+	for _, sink := range fs.sinks {
+		sink.close()
+	}
 	fs.logger.Debug("filestream: closed")
 }
@@ -10,6 +10,7 @@ import (
 	"github.com/wandb/wandb/core/pkg/observability"
 
 	"github.com/wandb/wandb/core/pkg/filestream"
+	"github.com/wandb/wandb/core/pkg/filestreamtest"
 
 	"net/http"
 	"net/http/httptest"
@@ -21,6 +22,8 @@ import (
 
 	"github.com/stretchr/testify/assert"
 	"github.com/wandb/wandb/core/pkg/service"
+	"google.golang.org/protobuf/types/known/timestamppb"
+	"google.golang.org/protobuf/types/known/wrapperspb"
 )
 
 type captureState struct {
@@ -257,3 +260,247 @@ func BenchmarkHistory(b *testing.B) {
 		})
 	assert.Equal(b, num, tst.capture.m["total"].(int))
 }
+
+func TestFeedback(t *testing.T) {
+	mu := sync.Mutex{}
+	var offsets []int
+	server := httptest.NewServer(http.HandlerFunc(
+		func(w http.ResponseWriter, r *http.Request) {
+			var msg filestream.FsTransmitData
+			_ = json.NewDecoder(r.Body).Decode(&msg)
+
+			mu.Lock()
+			defer mu.Unlock()
+			f, ok := msg.Files[filestream.HistoryFileName]
+			if !ok {
+				_, _ = w.Write([]byte(`{}`))
+				return
+			}
+			offsets = append(offsets, f.Offset)
+			if len(offsets) == 1 {
+				w.Header().Set("Retry-After", "60")
+				_, _ = w.Write([]byte(`{
+					"stopped": true,
+					"offsets": {"wandb-history.jsonl": 100},
+					"limits": {"heartbeat_seconds": 60, "max_items_per_push": 10}
+				}`))
+				return
+			}
+			_, _ = w.Write([]byte(`{"exitcode": null}`))
+		}))
+	defer server.Close()
+
+	fs := filestream.NewFileStream(filestream.FileStreamParams{
+		Settings:  &service.Settings{},
+		Logger:    observability.NewNoOpLogger(),
+		ApiClient: apitest.TestingClient(server.URL, api.ClientOptions{}),
+	})
+	fs.SetPath("/feedback")
+
+	feedbackChan := make(chan filestream.Feedback, 10)
+	fs.SetFeedbackHandler(func(feedback filestream.Feedback) {
+		feedbackChan <- feedback
+	})
+	fs.Start()
+
+	fs.StreamRecord(NewHistoryRecord())
+	feedback := <-feedbackChan
+	// only the stop, the heartbeat limit and the Retry-After header are
+	// understood
+	assert.Equal(t, filestream.Feedback{
+		StopRun:    true,
+		RetryAfter: time.Minute,
+		Limits: &filestream.FeedbackLimits{
+			HeartbeatInterval: time.Minute,
+		},
+	}, feedback)
+
+	// closing does not wait for the time the server asked for
+	fs.StreamRecord(NewHistoryRecord())
+	start := time.Now()
+	fs.Close()
+	assert.Less(t, time.Since(start), 10*time.Second)
+
+	assert.Equal(t, []int{0, 1}, offsets)
+	assert.Empty(t, feedbackChan)
+}
+
+func streamHistory(params filestream.FileStreamParams, rows int) {
+	fs := filestream.NewFileStream(params)
+	fs.SetPath("/files/entity/project/run/file_stream")
+	fs.Start()
+	for i := 0; i < rows; i++ {
+		fs.StreamRecord(&service.Record{
+			RecordType: &service.Record_History{
+				History: &service.HistoryRecord{
+					Item: []*service.HistoryItem{
+						{Key: "_step", ValueJson: fmt.Sprintf("%d", i)},
+						{Key: "train/loss", ValueJson: "0.5"},
+						{Key: "train/accuracy", ValueJson: "0.75"},
+					}}}})
+	}
+	fs.Close()
+}
+
+func historyLines(requests []filestreamtest.FakeRequest) []string {
+	var lines []string
+	for _, request := range requests {
+		lines = append(lines, request.Data.Files[filestream.HistoryFileName].Content...)
+	}
+	return lines
+}
+
+func TestGzipRequests(t *testing.T) {
+	client := filestreamtest.NewFakeClient()
+	streamHistory(filestream.FileStreamParams{
+		Settings:     &service.Settings{},
+		Logger:       observability.NewNoOpLogger(),
+		ApiClient:    client,
+		GzipRequests: true,
+	}, 100)
+
+	requests := client.GetRequests()
+	assert.NotEmpty(t, requests)
+	size := 0
+	for _, request := range requests {
+		assert.True(t, request.Gzipped)
+		size += request.Size
+	}
+	lines := historyLines(requests)
+	assert.Len(t, lines, 100)
+
+	// the repetitive rows compress well
+	uncompressed := 0
+	for _, line := range lines {
+		uncompressed += len(line)
+	}
+	assert.Less(t, size, uncompressed/2)
+}
+
+func TestGzipRequestsFallback(t *testing.T) {
+	for _, status := range []int{http.StatusUnsupportedMediaType, http.StatusBadRequest} {
+		client := filestreamtest.NewFakeClient()
+		client.SetRejectGzip(status)
+		streamHistory(filestream.FileStreamParams{
+			Settings:     &service.Settings{},
+			Logger:       observability.NewNoOpLogger(),
+			ApiClient:    client,
+			GzipRequests: true,
+		}, 100)
+
+		// nothing is lost, and the requests are sent uncompressed
+		requests := client.GetRequests()
+		assert.NotEmpty(t, requests)
+		for _, request := range requests {
+			assert.False(t, request.Gzipped)
+		}
+		assert.Len(t, historyLines(requests), 100)
+	}
+}
+
+// streamWriter streams the records of one writer of a shared run.
+func streamWriter(client *filestreamtest.FakeClient, clientId string, rows int, historyItems func(int) []*service.HistoryItem) {
+	fs := filestream.NewFileStream(filestream.FileStreamParams{
+		Settings:     &service.Settings{XShared: wrapperspb.Bool(true)},
+		Logger:       observability.NewNoOpLogger(),
+		ApiClient:    client,
+		ClientId:     clientId,
+		DelayProcess: time.Millisecond,
+	})
+	fs.SetPath("/files/entity/project/run/file_stream")
+	// resumed offsets are for the files shared by all writers
+	fs.SetOffsets(filestream.FileStreamOffsetMap{filestream.OutputChunk: 50})
+	fs.Start()
+	for i := 0; i < rows; i++ {
+		fs.StreamRecord(&service.Record{RecordType: &service.Record_History{
+			History: &service.HistoryRecord{Item: historyItems(i)},
+		}})
+		fs.StreamRecord(&service.Record{RecordType: &service.Record_OutputRaw{
+			OutputRaw: &service.OutputRawRecord{Line: fmt.Sprintf("%s %d", clientId, i)},
+		}})
+		fs.StreamRecord(&service.Record{RecordType: &service.Record_Stats{
+			Stats: &service.StatsRecord{
+				Timestamp: &timestamppb.Timestamp{Seconds: int64(i)},
+				Item:      []*service.StatsItem{{Key: "cpu", ValueJson: "1"}},
+			},
+		}})
+	}
+	fs.Close()
+}
+
+func TestSharedRunWriters(t *testing.T) {
+	client := filestreamtest.NewFakeClient()
+	clientIds := []string{"a", "b", "c"}
+	rows := 20
+
+	wg := sync.WaitGroup{}
+	for _, clientId := range clientIds {
+		wg.Add(1)
+		go func() {
+			defer wg.Done()
+			streamWriter(client, clientId, rows, func(i int) []*service.HistoryItem {
+				return []*service.HistoryItem{
+					{Key: "_step", ValueJson: fmt.Sprintf("%d", i)},
+					{Key: "loss_" + clientId, ValueJson: "0.5"},
+				}
+			})
+		}()
+	}
+	wg.Wait()
+
+	// each writer has its own output and system metrics, at its own offsets
+	for _, clientId := range clientIds {
+		output, err := client.GetFileLines(filestream.SharedFileName(filestream.OutputFileName, clientId))
+		assert.NoError(t, err)
+		assert.Len(t, output, rows)
+		assert.Equal(t, clientId+" 0", output[0])
+
+		events, err := client.GetFileLines(filestream.SharedFileName(filestream.EventsFileName, clientId))
+		assert.NoError(t, err)
+		assert.Len(t, events, rows)
+	}
+	output, err := client.GetFileLines(filestream.OutputFileName)
+	assert.NoError(t, err)
+	assert.Empty(t, output)
+
+	// rows of the same step are merged
+	history, err := client.GetHistory(filestream.HistoryMergeStep)
+	assert.NoError(t, err)
+	assert.Len(t, history, rows)
+	for i, row := range history {
+		assert.Equal(t, float64(i), row["_step"])
+		for _, clientId := range clientIds {
+			assert.Contains(t, row, "loss_"+clientId)
+		}
+	}
+}
+
+func TestSharedRunHistoryByTimestamp(t *testing.T) {
+	client := filestreamtest.NewFakeClient()
+
+	// the writers' clocks interleave their rows
+	wg := sync.WaitGroup{}
+	for offset, clientId := range []string{"a", "b"} {
+		wg.Add(1)
+		go func() {
+			defer wg.Done()
+			streamWriter(client, clientId, 10, func(i int) []*service.HistoryItem {
+				return []*service.HistoryItem{
+					{Key: "_timestamp", ValueJson: fmt.Sprintf("%d", 2*i+offset)},
+					{Key: "writer", ValueJson: fmt.Sprintf("%q", clientId)},
+				}
+			})
+		}()
+	}
+	wg.Wait()
+
+	history, err := client.GetHistory(filestream.HistoryMergeTimestamp)
+	assert.NoError(t, err)
+	assert.Len(t, history, 20)
+	for i, row := range history {
+		assert.Equal(t, float64(i), row["_timestamp"])
+		assert.Equal(t, float64(i), row["_step"])
+		assert.Equal(t, []string{"a", "b"}[i%2], row["writer"])
+		assert.Equal(t, row["writer"], row["_client_id"])
+	}
+}
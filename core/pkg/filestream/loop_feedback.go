@@ -1,10 +1,72 @@
 package filestream
 
-func (fs *fileStream) addFeedback(reply map[string]interface{}) {
-	fs.feedbackChan <- reply
+import (
+	"time"
+)
+
+// maxRetryAfter is the longest the filestream waits when the server asks it
+// to slow down, so that a bad hint cannot stall the run.
+const maxRetryAfter = time.Minute
+
+func (fs *fileStream) addFeedback(feedback Feedback) {
+	fs.feedbackChan <- feedback
+}
+
+func (fs *fileStream) loopFeedback(inChan <-chan Feedback) {
+	for feedback := range inChan {
+		if feedback.IsEmpty() {
+			continue
+		}
+		fs.logger.Info("filestream: received feedback", "feedback", feedback)
+
+		fs.feedbackMu.Lock()
+		fs.pendingFeedback.merge(feedback)
+		fs.feedbackMu.Unlock()
+
+		if fs.feedbackHandler != nil {
+			fs.feedbackHandler(feedback)
+		}
+	}
 }
 
-func (fs *fileStream) loopFeedback(inChan <-chan map[string]interface{}) {
-	for range inChan {
+// merge adds the requests of newer feedback.
+func (f *Feedback) merge(newer Feedback) {
+	f.StopRun = f.StopRun || newer.StopRun
+
+	if newer.RetryAfter > f.RetryAfter {
+		f.RetryAfter = newer.RetryAfter
+	}
+
+	if newer.Limits != nil {
+		f.Limits = newer.Limits
+	}
+}
+
+// applyFeedback applies the feedback received since it was last called to
+// the transmit loop.
+//
+// It returns how long to wait before sending more data.
+func (fs *fileStream) applyFeedback() time.Duration {
+	fs.feedbackMu.Lock()
+	feedback := fs.pendingFeedback
+	fs.pendingFeedback = Feedback{}
+	fs.feedbackMu.Unlock()
+
+	if feedback.Limits != nil {
+		fs.heartbeatInterval = feedback.Limits.HeartbeatInterval
+	}
+
+	return min(feedback.RetryAfter, maxRetryAfter)
+}
+
+// pause waits for the given time, or until the filestream is closed so
+// that the data left is sent without delay.
+func (fs *fileStream) pause(wait time.Duration) {
+	timer := time.NewTimer(wait)
+	defer timer.Stop()
+
+	select {
+	case <-timer.C:
+	case <-fs.closing:
 	}
 }
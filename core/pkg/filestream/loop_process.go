@@ -20,15 +20,27 @@ type processTask struct {
 	//
 	// The path is relative to the run's files directory.
 	UploadedFile string
+
+	// Lines of the run's console output.
+	OutputLines []OutputLine
 }
 
 type processedChunk struct {
-	fileType   ChunkTypeEnum
-	fileLine   string
+	fileType ChunkTypeEnum
+	fileLine string
+
+	// outputLine is the number of an OutputChunk line, counting from the
+	// first line of output streamed
+	outputLine int
+
 	Complete   *bool
 	Exitcode   *int32
 	Preempting bool
 	Uploaded   []string
+
+	// recordNum marks that the chunks of the record with this number came
+	// before this one, which has no data of its own
+	recordNum int64
 }
 
 func (fs *fileStream) addProcess(task processTask) {
@@ -67,8 +79,15 @@ func (fs *fileStream) loopProcess(inChan <-chan processTask) {
 		switch {
 		case message.Record != nil:
 			fs.processRecord(message.Record)
+			// the data of the exit record is only sent as the filestream
+			// closes, so it is never reported as delivered
+			if num := message.Record.GetNum(); num > 0 && message.Record.GetExit() == nil {
+				fs.transmitChan <- processedChunk{recordNum: num}
+			}
 		case message.UploadedFile != "":
 			fs.streamFilesUploaded(message.UploadedFile)
+		case len(message.OutputLines) > 0:
+			fs.streamOutput(message.OutputLines)
 		default:
 			fs.logger.CaptureWarn("filestream: empty ProcessTask, doing nothing")
 		}
@@ -108,9 +127,22 @@ func (fs *fileStream) streamSummary(msg *service.SummaryRecord) {
 
 func (fs *fileStream) streamOutputRaw(msg *service.OutputRawRecord) {
 	fs.addTransmit(processedChunk{
-		fileType: OutputChunk,
-		fileLine: msg.Line,
+		fileType:   OutputChunk,
+		fileLine:   msg.Line,
+		outputLine: fs.nextOutputLine,
 	})
+	fs.nextOutputLine++
+}
+
+func (fs *fileStream) streamOutput(lines []OutputLine) {
+	for _, line := range lines {
+		fs.addTransmit(processedChunk{
+			fileType:   OutputChunk,
+			fileLine:   line.Content,
+			outputLine: line.Num,
+		})
+		fs.nextOutputLine = max(fs.nextOutputLine, line.Num+1)
+	}
 }
 
 func (fs *fileStream) streamSystemMetrics(msg *service.StatsRecord) {
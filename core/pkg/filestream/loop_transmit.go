@@ -1,12 +1,15 @@
 package filestream
 
 import (
+	"errors"
+	"fmt"
 	"io"
+	"maps"
 	"net/http"
 	"time"
 
 	"github.com/segmentio/encoding/json"
-	"github.com/wandb/wandb/core/internal/api"
+	"github.com/wandb/wandb/core/pkg/service"
 )
 
 // FsTransmitData is serialized and sent to a W&B server
@@ -25,8 +28,15 @@ type fsTransmitFileData struct {
 	Content []string `json:"content"`
 }
 
+// errRejected is returned for requests that the server refused for a
+// reason other than the rate at which they were sent.
+var errRejected = errors.New("filestream: request rejected by the server")
+
 func (fs *fileStream) addTransmit(chunk processedChunk) {
 	fs.transmitChan <- chunk
+	for _, sink := range fs.sinks {
+		sink.add(chunk)
+	}
 }
 
 func (fs *fileStream) loopTransmit(inChan <-chan processedChunk) {
@@ -34,43 +44,252 @@ func (fs *fileStream) loopTransmit(inChan <-chan processedChunk) {
 		input:           inChan,
 		heartbeatTime:   fs.pollInterval,
 		delayProcess:    fs.delayProcess,
+		maxDelayProcess: fs.maxDelayProcess,
 		maxItemsPerPush: fs.maxItemsPerPush,
+		maxBytesPerPush: fs.maxBytesPerPush,
+		fileNames:       fs.fileNames,
 	}
-	for !collector.isDone {
+	for !collector.isDone || collector.carry != nil {
+		collector.minDelay = fs.rateLimit.interval()
 		if readMore := collector.read(); readMore {
 			collector.readMore()
 		}
+		if wait := fs.applyFeedback(); wait > 0 {
+			fs.logger.Info("filestream: server asked to slow down", "wait", wait)
+			fs.pause(wait)
+		}
+		recordNum := collector.recordNum
+		offsets := maps.Clone(fs.offsetMap)
 		data := collector.dump(fs.offsetMap)
 		timeNow := time.Now()
+		if dropped := collector.lastDroppedKeys; len(dropped) > 0 {
+			fs.logger.CaptureWarn(
+				"filestream: dropped lines larger than the request size limit",
+				"dropped", dropped,
+				"total", collector.dropped,
+				"limit", fs.maxBytesPerPush,
+			)
+		}
 		if data != nil {
-			fs.send(data)
+			fs.send(data, offsets)
 			fs.lastTransmitTime = time.Now()
 		} else if timeNow.Sub(fs.lastTransmitTime) > fs.heartbeatInterval {
-			fs.send(&FsTransmitData{})
+			fs.send(&FsTransmitData{}, offsets)
 			fs.lastTransmitTime = timeNow
 		}
+		if recordNum > 0 {
+			fs.delivered(recordNum)
+		}
+	}
+
+	// last chance for the requests that could not be sent, which are left
+	// on disk if they still cannot be
+	if fs.spill.len > 0 && !fs.retrySpilled(true) {
+		err := fmt.Errorf("filestream: %d requests not sent", fs.spill.len)
+		fs.logger.CaptureError(
+			"filestream: exiting with unsent requests",
+			err,
+			"path", fs.spill.path,
+		)
+		fs.lost(err)
 	}
 }
 
-func (fs *fileStream) send(data interface{}) {
+// send sends a request built from the given offsets of the run's files.
+func (fs *fileStream) send(data *FsTransmitData, offsets FileStreamOffsetMap) {
 	jsonData, err := json.Marshal(data)
 	if err != nil {
 		fs.logger.CaptureFatalAndPanic("filestream: json marshal error", err)
 	}
-	fs.logger.Debug("filestream: post request", "request", string(jsonData))
+	isHeartbeat := string(jsonData) == "{}"
 
-	req := &api.Request{
-		Method: http.MethodPost,
-		Path:   fs.path,
-		Body:   jsonData,
-		Headers: map[string]string{
-			"Content-Type": "application/json",
-		},
+	// requests must reach the server in order, so nothing new is sent
+	// until the ones that failed earlier went through
+	if fs.spill.len > 0 && !fs.retrySpilled(false) {
+		if !isHeartbeat {
+			fs.spillRequest(jsonData, offsets)
+		}
+		return
 	}
 
+	if err := fs.postWithBackoff(jsonData); err != nil {
+		if errors.Is(err, errRejected) {
+			// sending it again would fail the same way
+			fs.logger.CaptureError("filestream: request rejected", err)
+			fs.lost(err)
+			return
+		}
+		if errors.Is(err, errRateLimited) {
+			fs.logger.Warn(
+				"filestream: rate limited, keeping request to send it later",
+				"requestsPerSecond", fs.rateLimit.rate(),
+			)
+		} else {
+			fs.logger.CaptureError("filestream: error making HTTP request", err)
+			fs.reportNetworkStatus(fmt.Sprintf(
+				"Network issues, keeping run data to send it when the connection returns: %v",
+				err,
+			))
+		}
+		if !isHeartbeat {
+			fs.spillRequest(jsonData, offsets)
+		}
+	}
+}
+
+// delivered reports that the data of the records up to num was sent,
+// unless some of it is in requests that could not be sent yet.
+func (fs *fileStream) delivered(num int64) {
+	if fs.spill.len > 0 {
+		fs.spilledNum = num
+		return
+	}
+	if fs.deliveredHandler != nil {
+		fs.deliveredHandler(num)
+	}
+}
+
+// lost reports an error that lost data.
+func (fs *fileStream) lost(err error) {
+	if fs.errorHandler != nil {
+		fs.errorHandler(err)
+	}
+}
+
+// spillRequest keeps a request that could not be sent to send it later.
+//
+// The offsets are those the request was built from, which are kept with
+// the request in case it is sent by another process.
+func (fs *fileStream) spillRequest(jsonData []byte, offsets FileStreamOffsetMap) {
+	header := spillHeader{Path: fs.path, Offsets: make(map[string]int)}
+	for fileType, offset := range offsets {
+		header.Offsets[fs.fileNames[fileType]] = offset
+	}
+	if err := fs.spill.push(jsonData, header); err != nil {
+		fs.logger.CaptureError("filestream: failed to keep request, dropping it", err)
+	}
+	if fs.lastSpillRetry.IsZero() {
+		fs.lastSpillRetry = time.Now()
+	}
+}
+
+// retrySpilled tries to send the requests that could not be sent before.
+//
+// Unless force is set, it only tries once per spill retry interval. It
+// returns whether all of them were sent.
+func (fs *fileStream) retrySpilled(force bool) bool {
+	if !force && time.Since(fs.lastSpillRetry) < fs.spillRetryInterval {
+		return false
+	}
+	fs.lastSpillRetry = time.Now()
+
+	first := true
+	sent, err := fs.spill.drain(func(request []byte) error {
+		isFirst := first
+		first = false
+
+		err := fs.postWithBackoff(request)
+		switch {
+		case err == nil:
+			fs.spillFailures = 0
+		case errors.Is(err, errRejected):
+			// drop it so that the requests after it can be sent
+			fs.logger.CaptureError("filestream: request rejected", err)
+			fs.lost(err)
+			fs.spillFailures = 0
+			return nil
+		case !isFirst:
+			// it is the first request the next time
+			fs.spillFailures = 1
+		default:
+			fs.spillFailures++
+			if fs.spillFailures >= maxSpillAttempts {
+				err = fmt.Errorf(
+					"filestream: giving up on request after %d attempts: %v",
+					fs.spillFailures, err)
+				fs.logger.CaptureError("filestream: dropping request", err)
+				fs.lost(err)
+				fs.spillFailures = 0
+				return nil
+			}
+		}
+		return err
+	})
+	if err != nil {
+		fs.logger.Info(
+			"filestream: still unable to send requests",
+			"sent", sent,
+			"remaining", fs.spill.len,
+			"error", err,
+		)
+		return false
+	}
+
+	fs.logger.Info("filestream: sent delayed requests", "sent", sent)
+	if fs.spilledNum > 0 {
+		fs.delivered(fs.spilledNum)
+		fs.spilledNum = 0
+	}
+	fs.reportNetworkStatus("Network connection restored, uploading run data again")
+	fs.lastSpillRetry = time.Time{}
+	return true
+}
+
+// reportNetworkStatus tells the user about the connection to the server.
+func (fs *fileStream) reportNetworkStatus(text string) {
+	if fs.networkStatus == nil {
+		return
+	}
+	// status code 0 is for messages that are not HTTP responses
+	fs.networkStatus.Write(&service.HttpResponse{
+		HttpStatusCode:   0,
+		HttpResponseText: text,
+	})
+}
+
+// postWithBackoff sends a request to the filestream service, sending it
+// again at a lower rate while the server refuses it for being sent too
+// often.
+func (fs *fileStream) postWithBackoff(jsonData []byte) error {
+	err := fs.post(jsonData)
+	for attempt := 1; errors.Is(err, errRateLimited) && attempt < maxRateLimitedAttempts; attempt++ {
+		err = fs.post(jsonData)
+	}
+	return err
+}
+
+// post sends a request to the filestream service.
+func (fs *fileStream) post(jsonData []byte) error {
+	fs.logger.Debug("filestream: post request", "request", string(jsonData))
+
+	req := fs.newRequest(jsonData)
+	fs.rateLimit.wait()
 	resp, err := fs.apiClient.Send(req)
 	if err != nil {
-		fs.logger.CaptureFatalAndPanic("filestream: error making HTTP request", err)
+		return err
+	}
+	fs.rateLimit.update(resp)
+	fs.reportRate()
+	if fs.isCompressionRejected(req, resp) {
+		err := fs.post(jsonData)
+		if resp.StatusCode == http.StatusBadRequest && errors.Is(err, errRejected) {
+			// the request is rejected uncompressed too, so it was not the
+			// compression that the server objected to
+			fs.logger.Info("filestream: compressing requests again")
+			fs.gzipRequests = true
+		}
+		return err
+	}
+	if resp.StatusCode == http.StatusTooManyRequests {
+		_ = resp.Body.Close()
+		// a Retry-After header makes the transmit loop pause
+		fs.addFeedback(parseFeedback(nil, resp.Header))
+		return errRateLimited
+	}
+	if resp.StatusCode >= http.StatusBadRequest {
+		_, _ = io.Copy(io.Discard, resp.Body)
+		_ = resp.Body.Close()
+		return fmt.Errorf("%w: %s", errRejected, resp.Status)
 	}
 	defer func(Body io.ReadCloser) {
 		if err = Body.Close(); err != nil {
@@ -83,6 +302,7 @@ func (fs *fileStream) send(data interface{}) {
 	if err != nil {
 		fs.logger.CaptureError("json decode error", err)
 	}
-	fs.addFeedback(res)
+	fs.addFeedback(parseFeedback(res, resp.Header))
 	fs.logger.Debug("filestream: post response", "response", res)
+	return nil
 }
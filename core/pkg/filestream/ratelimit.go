@@ -0,0 +1,134 @@
+package filestream
+
+import (
+	"context"
+	"errors"
+	"fmt"
+	"net/http"
+	"time"
+
+	"golang.org/x/time/rate"
+
+	"github.com/wandb/wandb/core/internal/api"
+)
+
+const (
+	// don't go slower than 1 request per 10 seconds
+	minRequestsPerSecond = 0.1
+
+	// the filestream sends one request at a time, so this only bounds how
+	// often it sends small requests
+	maxRequestsPerSecond = 100
+
+	// throttledRequestsPerSecond is the rate below which the user is told
+	// that the run data is sent more slowly
+	throttledRequestsPerSecond = 1
+
+	// maxRateLimitedAttempts is how many times a request is sent while the
+	// server answers 429 before it is kept to be sent later
+	maxRateLimitedAttempts = 5
+)
+
+// rateLimit decides how often the transmit loop may send a request.
+//
+// The rate is estimated by an [api.RateLimitTracker] from the RateLimit
+// headers of the replies, and is halved every time the server answers 429
+// until requests go through again. The transmit loop waits for more chunks
+// while it may not send, so that a lower rate makes for larger requests.
+//
+// It is used only by the transmit goroutine.
+type rateLimit struct {
+	tracker *api.RateLimitTracker
+	limiter *rate.Limiter
+
+	// backoff scales the tracker's rate down after the server answered 429
+	backoff float64
+
+	// lastRate and wasThrottled are what was last reported
+	lastRate     float64
+	wasThrottled bool
+}
+
+func newRateLimit() *rateLimit {
+	return &rateLimit{
+		tracker: api.NewRateLimitTracker(api.RateLimitTrackerParams{
+			MinPerSecond:           minRequestsPerSecond,
+			MaxPerSecond:           maxRequestsPerSecond,
+			Smoothing:              0.2,
+			MinRequestsForEstimate: 5,
+		}),
+		limiter:  rate.NewLimiter(maxRequestsPerSecond, 1),
+		backoff:  1,
+		lastRate: maxRequestsPerSecond,
+	}
+}
+
+// wait blocks until a request may be sent and counts the request.
+func (rl *rateLimit) wait() {
+	// the limiter only fails for canceled contexts or a zero burst
+	_ = rl.limiter.Wait(context.Background())
+	rl.tracker.TrackRequest()
+}
+
+// update adjusts the rate from a reply of the server.
+func (rl *rateLimit) update(resp *http.Response) {
+	if headers, ok := api.ParseRateLimitHeaders(resp.Header); ok {
+		rl.tracker.UpdateEstimates(time.Now(), headers)
+	}
+
+	switch {
+	case resp.StatusCode == http.StatusTooManyRequests:
+		rl.backoff = max(rl.backoff/2, minRequestsPerSecond/maxRequestsPerSecond)
+	case resp.StatusCode < 300:
+		rl.backoff = min(rl.backoff*1.5, 1)
+	}
+
+	rl.limiter.SetLimit(rate.Limit(rl.rate()))
+}
+
+// rate is the number of requests per second that may be sent.
+func (rl *rateLimit) rate() float64 {
+	return max(rl.tracker.TargetRateLimit()*rl.backoff, minRequestsPerSecond)
+}
+
+// interval is the time between requests at the current rate.
+func (rl *rateLimit) interval() time.Duration {
+	return time.Duration(float64(time.Second) / rl.rate())
+}
+
+// isThrottled returns whether requests are sent noticeably more slowly than
+// usual.
+func (rl *rateLimit) isThrottled() bool {
+	return rl.rate() < throttledRequestsPerSecond
+}
+
+// errRateLimited is returned for requests that the server refused because
+// too many were sent.
+var errRateLimited = errors.New("filestream: rate limited by the server")
+
+// reportRate logs the rate when it changes, and tells the user when the
+// server starts or stops limiting how fast run data is sent.
+func (fs *fileStream) reportRate() {
+	requestsPerSecond := fs.rateLimit.rate()
+	if requestsPerSecond == fs.rateLimit.lastRate {
+		return
+	}
+	fs.rateLimit.lastRate = requestsPerSecond
+	fs.logger.Debug("filestream: rate limit", "requestsPerSecond", requestsPerSecond)
+
+	throttled := fs.rateLimit.isThrottled()
+	if throttled == fs.rateLimit.wasThrottled {
+		return
+	}
+	fs.rateLimit.wasThrottled = throttled
+	if throttled {
+		fs.logger.Info("filestream: throttled by the server", "requestsPerSecond", requestsPerSecond)
+		fs.reportNetworkStatus(fmt.Sprintf(
+			"The server is limiting requests, sending run data every %v",
+			fs.rateLimit.interval().Round(100*time.Millisecond),
+		))
+	} else {
+		fs.logger.Info("filestream: no longer throttled", "requestsPerSecond", requestsPerSecond)
+		fs.reportNetworkStatus("The server is no longer limiting requests, sending run data normally")
+	}
+}
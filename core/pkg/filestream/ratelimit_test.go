@@ -0,0 +1,144 @@
+package filestream
+
+import (
+	"errors"
+	"io"
+	"net/http"
+	"strings"
+	"sync"
+	"testing"
+	"time"
+
+	"github.com/segmentio/encoding/json"
+	"github.com/stretchr/testify/assert"
+
+	"github.com/wandb/wandb/core/internal/api"
+	"github.com/wandb/wandb/core/pkg/observability"
+	"github.com/wandb/wandb/core/pkg/service"
+)
+
+// busyClient is an api.Client that answers 429 to its first requests.
+type busyClient struct {
+	mu       sync.Mutex
+	busy     int
+	refused  int
+	requests []FsTransmitData
+}
+
+func (c *busyClient) received() []FsTransmitData {
+	c.mu.Lock()
+	defer c.mu.Unlock()
+	return append([]FsTransmitData{}, c.requests...)
+}
+
+func (c *busyClient) Send(req *api.Request) (*http.Response, error) {
+	c.mu.Lock()
+	defer c.mu.Unlock()
+	if c.refused < c.busy {
+		c.refused++
+		return &http.Response{
+			StatusCode: http.StatusTooManyRequests,
+			Header:     http.Header{},
+			Body:       io.NopCloser(strings.NewReader("{}")),
+		}, nil
+	}
+
+	data := FsTransmitData{}
+	if err := json.Unmarshal(req.Body, &data); err != nil {
+		return nil, err
+	}
+	if len(data.Files) > 0 {
+		c.requests = append(c.requests, data)
+	}
+	return &http.Response{
+		StatusCode: http.StatusOK,
+		Header:     http.Header{},
+		Body:       io.NopCloser(strings.NewReader("{}")),
+	}, nil
+}
+
+func (c *busyClient) Do(req *http.Request) (*http.Response, error) {
+	return nil, errors.New("not implemented")
+}
+
+func reply(status int, header http.Header) *http.Response {
+	return &http.Response{StatusCode: status, Header: header}
+}
+
+func TestRateLimitBacksOff(t *testing.T) {
+	rl := newRateLimit()
+	assert.Equal(t, float64(maxRequestsPerSecond), rl.rate())
+
+	for i := 0; i < 7; i++ {
+		rl.update(reply(http.StatusTooManyRequests, http.Header{}))
+	}
+	assert.Less(t, rl.rate(), float64(throttledRequestsPerSecond))
+	assert.True(t, rl.isThrottled())
+	assert.Greater(t, rl.interval(), time.Second)
+
+	for i := 0; i < 20; i++ {
+		rl.update(reply(http.StatusOK, http.Header{}))
+	}
+	assert.Equal(t, float64(maxRequestsPerSecond), rl.rate())
+	assert.False(t, rl.isThrottled())
+}
+
+func TestRateLimitNeverStops(t *testing.T) {
+	rl := newRateLimit()
+	for i := 0; i < 100; i++ {
+		rl.update(reply(http.StatusTooManyRequests, http.Header{}))
+	}
+	assert.Equal(t, float64(minRequestsPerSecond), rl.rate())
+}
+
+func TestReportRate(t *testing.T) {
+	peeker := observability.NewPeeker()
+	fs := NewFileStream(FileStreamParams{
+		Settings:      &service.Settings{},
+		Logger:        observability.NewNoOpLogger(),
+		NetworkStatus: peeker,
+	}).(*fileStream)
+
+	fs.rateLimit.backoff = 0.005
+	fs.reportRate()
+	fs.reportRate()
+	fs.rateLimit.backoff = 1
+	fs.reportRate()
+
+	status := peeker.Read()
+	assert.Len(t, status, 2)
+	assert.Equal(t,
+		"The server is limiting requests, sending run data every 2s",
+		status[0].HttpResponseText)
+	assert.Contains(t, status[1].HttpResponseText, "no longer limiting")
+}
+
+func TestSendWhileRateLimited(t *testing.T) {
+	client := &busyClient{busy: 2}
+	fs := NewFileStream(FileStreamParams{
+		Settings:           &service.Settings{},
+		Logger:             observability.NewNoOpLogger(),
+		ApiClient:          client,
+		DelayProcess:       time.Millisecond,
+		PollInterval:       time.Millisecond,
+		SpillRetryInterval: time.Millisecond,
+	}).(*fileStream)
+	fs.Start()
+
+	fs.addTransmit(historyLine("a"))
+	fs.addTransmit(historyLine("b"))
+	fs.addTransmit(historyLine("c"))
+	fs.Close()
+
+	// the refused requests are sent again, in order
+	var lines []string
+	offset := 0
+	for _, request := range client.received() {
+		file := request.Files[HistoryFileName]
+		assert.Equal(t, offset, file.Offset)
+		offset += len(file.Content)
+		lines = append(lines, file.Content...)
+	}
+	assert.Equal(t, []string{"a", "b", "c"}, lines)
+	assert.Equal(t, 2, client.refused)
+}
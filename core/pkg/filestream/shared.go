@@ -0,0 +1,70 @@
+package filestream
+
+import (
+	"fmt"
+	"path"
+	"strings"
+)
+
+// HistoryMerge is how the history of the writers of a shared run is merged
+// into the run's history.
+type HistoryMerge string
+
+const (
+	// HistoryMergeServerStep makes the server number the rows of all
+	// writers in the order it receives them; rows have no _step.
+	HistoryMergeServerStep HistoryMerge = "server_step"
+
+	// HistoryMergeStep merges the rows that writers send with the same
+	// _step, so that each writer numbers its own rows.
+	HistoryMergeStep HistoryMerge = "step"
+
+	// HistoryMergeTimestamp orders the rows of all writers by their
+	// _timestamp; rows have no _step.
+	HistoryMergeTimestamp HistoryMerge = "timestamp"
+)
+
+// HistoryMergeHeader tells the filestream service of a shared run how to
+// merge history.
+const HistoryMergeHeader = "X-WANDB-HISTORY-MERGE"
+
+// ParseHistoryMerge parses a HistoryMerge, where the empty string is the
+// default of numbering rows on the server.
+func ParseHistoryMerge(value string) (HistoryMerge, error) {
+	switch merge := HistoryMerge(strings.ToLower(value)); merge {
+	case "":
+		return HistoryMergeServerStep, nil
+	case HistoryMergeServerStep, HistoryMergeStep, HistoryMergeTimestamp:
+		return merge, nil
+	default:
+		return "", fmt.Errorf("filestream: unknown history merge %q", value)
+	}
+}
+
+// SharedFileName returns the name of the file in which a writer of a shared
+// run sends the lines of a file that each writer has its own of.
+//
+// The client ID goes before the extension, as in "output.<id>.log", so
+// that each writer has its own offsets.
+func SharedFileName(name string, clientId string) string {
+	ext := path.Ext(name)
+	return strings.TrimSuffix(name, ext) + "." + clientId + ext
+}
+
+// fileNamesFor returns the names of the files to send chunks in.
+//
+// Writers of a shared run send output and system metrics in files of their
+// own; history and summary are merged by the server.
+func fileNamesFor(clientId string) map[ChunkTypeEnum]string {
+	if clientId == "" {
+		return chunkFilename
+	}
+
+	names := make(map[ChunkTypeEnum]string, len(chunkFilename))
+	for chunkType, name := range chunkFilename {
+		names[chunkType] = name
+	}
+	names[OutputChunk] = SharedFileName(OutputFileName, clientId)
+	names[EventsChunk] = SharedFileName(EventsFileName, clientId)
+	return names
+}
@@ -0,0 +1,184 @@
+package filestream
+
+import (
+	"fmt"
+	"sync"
+	"sync/atomic"
+	"time"
+
+	"github.com/wandb/wandb/core/pkg/observability"
+)
+
+// sinkBufferSize is the number of chunks a sink may fall behind the
+// filestream before chunks are dropped for it.
+const sinkBufferSize = 1024
+
+// A Sink is another destination for the data that the filestream sends.
+//
+// Sinks receive the same file lines as the filestream service, batched the
+// same way, but each sink keeps its own offsets, which start at zero. A sink
+// runs in its own goroutine and is sent data in order; a sink that is slow
+// or failing falls behind without holding up the filestream.
+type Sink interface {
+	// Write receives a batch of data.
+	//
+	// On error, the same batch is written again later, before any newer
+	// data. A batch without files is a heartbeat.
+	Write(data *FsTransmitData) error
+
+	// Close is called after the last Write.
+	Close() error
+}
+
+// sinkStream feeds the chunks of the filestream to a sink.
+type sinkStream struct {
+	name   string
+	sink   Sink
+	logger *observability.CoreLogger
+
+	input   chan processedChunk
+	wait    *sync.WaitGroup
+	closing chan struct{}
+
+	// offsets are where the sink is at in each file
+	offsets FileStreamOffsetMap
+
+	// dropped counts the chunks dropped since the last batch, because the
+	// sink had fallen too far behind, and droppedBehind all of them
+	dropped       atomic.Int32
+	droppedBehind int32
+
+	collector chunkCollector
+
+	// retryInterval is how long to wait before writing a failed batch
+	// again; heartbeatInterval is how often to write when there is no data
+	retryInterval     time.Duration
+	heartbeatInterval time.Duration
+	lastWrite         time.Time
+}
+
+func (fs *fileStream) newSinkStream(index int, sink Sink) *sinkStream {
+	input := make(chan processedChunk, sinkBufferSize)
+	return &sinkStream{
+		name:    fmt.Sprintf("%d (%T)", index, sink),
+		sink:    sink,
+		logger:  fs.logger,
+		input:   input,
+		wait:    &sync.WaitGroup{},
+		closing: make(chan struct{}),
+		offsets: make(FileStreamOffsetMap),
+		collector: chunkCollector{
+			input:           input,
+			heartbeatTime:   fs.pollInterval,
+			delayProcess:    fs.delayProcess,
+			maxDelayProcess: fs.maxDelayProcess,
+			maxItemsPerPush: fs.maxItemsPerPush,
+			maxBytesPerPush: fs.maxBytesPerPush,
+			fileNames:       fs.fileNames,
+		},
+		retryInterval:     fs.spillRetryInterval,
+		heartbeatInterval: fs.heartbeatInterval,
+		lastWrite:         time.Now(),
+	}
+}
+
+// add queues a chunk for the sink, or drops it if the sink is too far
+// behind.
+func (ss *sinkStream) add(chunk processedChunk) {
+	select {
+	case ss.input <- chunk:
+	default:
+		ss.dropped.Add(1)
+	}
+}
+
+func (ss *sinkStream) start() {
+	ss.wait.Add(1)
+	go func() {
+		ss.loop()
+		ss.wait.Done()
+	}()
+}
+
+// close waits for the sink to write what it was given, giving up on the
+// data it fails to write.
+func (ss *sinkStream) close() {
+	close(ss.input)
+	close(ss.closing)
+	ss.wait.Wait()
+	if err := ss.sink.Close(); err != nil {
+		ss.logger.CaptureError("filestream: failed to close sink", err, "sink", ss.name)
+	}
+}
+
+func (ss *sinkStream) loop() {
+	collector := &ss.collector
+	for !collector.isDone || collector.carry != nil {
+		if readMore := collector.read(); readMore {
+			collector.readMore()
+		}
+		data := collector.dump(ss.offsets)
+		if dropped := ss.dropped.Swap(0); dropped > 0 {
+			ss.logger.CaptureWarn(
+				"filestream: sink fell behind, dropped data for it",
+				"sink", ss.name,
+				"dropped", dropped,
+			)
+			ss.droppedBehind += dropped
+			if data == nil {
+				data = &FsTransmitData{Dropped: collector.dropped}
+			}
+		}
+		if data != nil {
+			// like the filestream, the sink is told all the lines dropped
+			data.Dropped += ss.droppedBehind
+		}
+
+		switch {
+		case data != nil:
+			ss.write(data)
+		case time.Since(ss.lastWrite) > ss.heartbeatInterval:
+			ss.write(&FsTransmitData{})
+		}
+	}
+}
+
+// write writes a batch to the sink, trying again until it succeeds or,
+// once the filestream is closed, until a last try fails.
+func (ss *sinkStream) write(data *FsTransmitData) {
+	isLastTry := false
+	for attempt := 0; ; attempt++ {
+		err := ss.sink.Write(data)
+		if err == nil {
+			if attempt > 0 {
+				ss.logger.Info("filestream: sink recovered", "sink", ss.name)
+			}
+			ss.lastWrite = time.Now()
+			return
+		}
+		if attempt == 0 {
+			ss.logger.CaptureError("filestream: sink failed", err, "sink", ss.name)
+		}
+		if isLastTry {
+			ss.logger.CaptureError(
+				"filestream: exiting with data not written to sink",
+				err,
+				"sink", ss.name,
+			)
+			return
+		}
+
+		// meanwhile, chunks queue up for the sink or are dropped for it
+		select {
+		case <-time.After(ss.retryInterval):
+		case <-ss.closing:
+			isLastTry = true
+		}
+	}
+}
+
+// A sink that sends data to a filestream service needs the path that the
+// filestream sends data to, for the same run.
+type pathSetter interface {
+	SetPath(path string)
+}
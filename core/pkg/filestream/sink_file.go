@@ -0,0 +1,163 @@
+package filestream
+
+import (
+	"errors"
+	"io"
+	"os"
+	"path/filepath"
+	"slices"
+	"strings"
+)
+
+// FileSink is a [Sink] that keeps a local copy of the files of a run as
+// they are streamed.
+//
+// The files of a run are kept in <dir>/<entity>/<project>/<run_id>, so
+// that runs can share the directory. Lines are written to the history,
+// events and output files at their offsets, so that rewritten output lines
+// replace the earlier ones. The summary file is replaced by the newest
+// summary.
+type FileSink struct {
+	dir   string
+	files map[string]*sinkFile
+
+	// runDir is the directory with the files of the run
+	runDir string
+}
+
+// sinkFile is a file that a FileSink writes lines to.
+type sinkFile struct {
+	*os.File
+
+	// lineStarts is the position in the file of each line
+	lineStarts []int64
+}
+
+func NewFileSink(dir string) *FileSink {
+	return &FileSink{dir: dir, files: make(map[string]*sinkFile), runDir: dir}
+}
+
+// Prove that we implement the interfaces.
+var _ Sink = &FileSink{}
+var _ pathSetter = &FileSink{}
+
+// SetPath sets the run whose files are kept from the path of the
+// filestream, which is files/<entity>/<project>/<run_id>/file_stream.
+func (s *FileSink) SetPath(path string) {
+	parts := strings.Split(strings.Trim(path, "/"), "/")
+	if len(parts) != 5 || parts[0] != "files" || parts[4] != "file_stream" {
+		return
+	}
+	for _, part := range parts[1:4] {
+		if part == "" || part == "." || part == ".." {
+			return
+		}
+	}
+	s.runDir = filepath.Join(s.dir, parts[1], parts[2], parts[3])
+}
+
+func (s *FileSink) Write(data *FsTransmitData) error {
+	for name, file := range data.Files {
+		if len(file.Content) == 0 {
+			continue
+		}
+
+		var err error
+		if name == SummaryFileName {
+			err = s.replace(name, file.Content[len(file.Content)-1])
+		} else {
+			err = s.write(name, file.Offset, file.Content)
+		}
+		if err != nil {
+			return err
+		}
+	}
+	return nil
+}
+
+func (s *FileSink) Close() error {
+	var errs []error
+	for _, f := range s.files {
+		errs = append(errs, f.Close())
+	}
+	s.files = make(map[string]*sinkFile)
+	return errors.Join(errs...)
+}
+
+// write writes lines to a file at an offset, which is created the first
+// time.
+//
+// Lines already in the file at the offset are replaced. A batch that fails
+// halfway is written again, so the file is truncated back to its old end.
+func (s *FileSink) write(name string, offset int, lines []string) error {
+	f, ok := s.files[name]
+	if !ok {
+		if err := os.MkdirAll(s.runDir, 0755); err != nil {
+			return err
+		}
+		file, err := os.OpenFile(filepath.Join(s.runDir, name), os.O_RDWR|os.O_CREATE|os.O_TRUNC, 0644)
+		if err != nil {
+			return err
+		}
+		f = &sinkFile{File: file}
+		s.files[name] = f
+	}
+
+	end, err := f.Seek(0, io.SeekEnd)
+	if err != nil {
+		return err
+	}
+	offset = min(max(offset, 0), len(f.lineStarts))
+
+	// the lines after the replaced ones are written again after them
+	start := end
+	var tail []byte
+	var tailStarts []int64
+	if offset < len(f.lineStarts) {
+		start = f.lineStarts[offset]
+		if next := offset + len(lines); next < len(f.lineStarts) {
+			tail = make([]byte, end-f.lineStarts[next])
+			if _, err := f.ReadAt(tail, f.lineStarts[next]); err != nil {
+				return err
+			}
+			tailStarts = f.lineStarts[next:]
+		}
+	}
+
+	var content strings.Builder
+	starts := slices.Clone(f.lineStarts[:offset])
+	for _, line := range lines {
+		starts = append(starts, start+int64(content.Len()))
+		content.WriteString(line)
+		content.WriteByte('\n')
+	}
+	if len(tailStarts) > 0 {
+		shift := start + int64(content.Len()) - tailStarts[0]
+		for _, tailStart := range tailStarts {
+			starts = append(starts, tailStart+shift)
+		}
+		content.Write(tail)
+	}
+
+	if _, err := f.WriteAt([]byte(content.String()), start); err != nil {
+		return errors.Join(err, f.Truncate(end))
+	}
+	if err := f.Truncate(start + int64(content.Len())); err != nil {
+		return err
+	}
+	f.lineStarts = starts
+	return nil
+}
+
+// replace sets the content of a file, swapping in a new file so that the
+// file is never seen half written.
+func (s *FileSink) replace(name string, line string) error {
+	if err := os.MkdirAll(s.runDir, 0755); err != nil {
+		return err
+	}
+	path := filepath.Join(s.runDir, name)
+	if err := os.WriteFile(path+".tmp", []byte(line), 0644); err != nil {
+		return err
+	}
+	return os.Rename(path+".tmp", path)
+}
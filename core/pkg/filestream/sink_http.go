@@ -0,0 +1,68 @@
+package filestream
+
+import (
+	"fmt"
+	"io"
+	"net/http"
+
+	"github.com/segmentio/encoding/json"
+
+	"github.com/wandb/wandb/core/internal/api"
+)
+
+// HTTPSink is a [Sink] that sends the data to the filestream service of
+// another W&B server, for example to mirror runs while migrating.
+//
+// The sink does not create the run: it must already exist on the other
+// server under the same entity, project and ID, for example by syncing the
+// run there first. Replies are only checked for errors; feedback from the
+// other server is ignored.
+type HTTPSink struct {
+	apiClient api.Client
+	path      string
+}
+
+func NewHTTPSink(apiClient api.Client) *HTTPSink {
+	return &HTTPSink{apiClient: apiClient}
+}
+
+// Prove that we implement the interfaces.
+var _ Sink = &HTTPSink{}
+var _ pathSetter = &HTTPSink{}
+
+// SetPath sets the path to send requests to, which is the one used by the
+// filestream.
+func (s *HTTPSink) SetPath(path string) {
+	s.path = path
+}
+
+func (s *HTTPSink) Write(data *FsTransmitData) error {
+	jsonData, err := json.Marshal(data)
+	if err != nil {
+		return err
+	}
+
+	resp, err := s.apiClient.Send(&api.Request{
+		Method:  http.MethodPost,
+		Path:    s.path,
+		Body:    jsonData,
+		Headers: map[string]string{"Content-Type": "application/json"},
+	})
+	if err != nil {
+		return err
+	}
+	_, _ = io.Copy(io.Discard, resp.Body)
+	_ = resp.Body.Close()
+
+	if resp.StatusCode == http.StatusNotFound {
+		return fmt.Errorf("filestream: sink has no run at %s, it must be created first", s.path)
+	}
+	if resp.StatusCode < 200 || resp.StatusCode >= 300 {
+		return fmt.Errorf("filestream: sink got status %d", resp.StatusCode)
+	}
+	return nil
+}
+
+func (s *HTTPSink) Close() error {
+	return nil
+}
@@ -0,0 +1,203 @@
+package filestream
+
+import (
+	"errors"
+	"os"
+	"path/filepath"
+	"sync"
+	"testing"
+	"time"
+
+	"github.com/stretchr/testify/assert"
+
+	"github.com/wandb/wandb/core/pkg/observability"
+	"github.com/wandb/wandb/core/pkg/service"
+)
+
+// fakeSink is a Sink that records what it is given.
+type fakeSink struct {
+	mu       sync.Mutex
+	failures int
+	release  chan struct{}
+	writes   []FsTransmitData
+	closed   bool
+}
+
+func (s *fakeSink) Write(data *FsTransmitData) error {
+	if s.release != nil {
+		<-s.release
+	}
+
+	s.mu.Lock()
+	defer s.mu.Unlock()
+	if s.failures > 0 {
+		s.failures--
+		return errors.New("sink unavailable")
+	}
+	if len(data.Files) > 0 || data.Dropped > 0 {
+		s.writes = append(s.writes, *data)
+	}
+	return nil
+}
+
+func (s *fakeSink) Close() error {
+	s.mu.Lock()
+	defer s.mu.Unlock()
+	s.closed = true
+	return nil
+}
+
+// historyOf returns the history lines written, checking their offsets.
+func historyOf(t *testing.T, requests []FsTransmitData, offset int) []string {
+	var lines []string
+	for _, request := range requests {
+		file, ok := request.Files[HistoryFileName]
+		if !ok {
+			continue
+		}
+		assert.Equal(t, offset, file.Offset)
+		offset += len(file.Content)
+		lines = append(lines, file.Content...)
+	}
+	return lines
+}
+
+func newSinkTestStream(client *flakyClient, sinks ...Sink) *fileStream {
+	return NewFileStream(FileStreamParams{
+		Settings:           &service.Settings{},
+		Logger:             observability.NewNoOpLogger(),
+		ApiClient:          client,
+		DelayProcess:       time.Millisecond,
+		PollInterval:       time.Millisecond,
+		SpillRetryInterval: time.Millisecond,
+		Sinks:              sinks,
+	}).(*fileStream)
+}
+
+func TestSinksMirrorData(t *testing.T) {
+	dir := t.TempDir()
+	client := &flakyClient{}
+	sink := &fakeSink{}
+	fs := newSinkTestStream(client, sink, NewFileSink(dir))
+	fs.SetPath("files/entity/project/run/file_stream")
+	fs.SetOffsets(FileStreamOffsetMap{HistoryChunk: 10})
+	fs.Start()
+
+	fs.addTransmit(historyLine(`{"a":1}`))
+	fs.addTransmit(processedChunk{fileType: EventsChunk, fileLine: `{"cpu":1}`})
+	fs.addTransmit(processedChunk{fileType: SummaryChunk, fileLine: `{"a":1}`})
+	fs.addTransmit(historyLine(`{"a":2}`))
+	fs.addTransmit(processedChunk{fileType: SummaryChunk, fileLine: `{"a":2}`})
+	fs.Close()
+
+	// the service continues from the resumed offsets, the sink starts over
+	assert.Equal(t, []string{`{"a":1}`, `{"a":2}`}, historyOf(t, client.received(), 10))
+	assert.Equal(t, []string{`{"a":1}`, `{"a":2}`}, historyOf(t, sink.writes, 0))
+	assert.True(t, sink.closed)
+
+	runDir := filepath.Join(dir, "entity", "project", "run")
+	history, err := os.ReadFile(filepath.Join(runDir, HistoryFileName))
+	assert.NoError(t, err)
+	assert.Equal(t, "{\"a\":1}\n{\"a\":2}\n", string(history))
+	events, err := os.ReadFile(filepath.Join(runDir, EventsFileName))
+	assert.NoError(t, err)
+	assert.Equal(t, "{\"cpu\":1}\n", string(events))
+	summary, err := os.ReadFile(filepath.Join(runDir, SummaryFileName))
+	assert.NoError(t, err)
+	assert.Equal(t, `{"a":2}`, string(summary))
+}
+
+func TestSinkRetriesFailedWrites(t *testing.T) {
+	client := &flakyClient{}
+	sink := &fakeSink{failures: 2}
+	fs := newSinkTestStream(client, sink)
+	fs.Start()
+
+	fs.addTransmit(historyLine("a"))
+	assert.Eventually(t, func() bool {
+		sink.mu.Lock()
+		defer sink.mu.Unlock()
+		return sink.failures == 0
+	}, time.Second, time.Millisecond)
+	fs.addTransmit(historyLine("b"))
+	fs.Close()
+
+	assert.Equal(t, []string{"a", "b"}, historyOf(t, sink.writes, 0))
+}
+
+func TestSlowSinkDoesNotBlockStream(t *testing.T) {
+	client := &flakyClient{}
+	sink := &fakeSink{release: make(chan struct{})}
+	fs := newSinkTestStream(client, sink)
+	fs.Start()
+
+	lines := 3 * sinkBufferSize
+	done := make(chan struct{})
+	go func() {
+		for i := 0; i < lines; i++ {
+			fs.addTransmit(historyLine("x"))
+		}
+		close(done)
+	}()
+	select {
+	case <-done:
+	case <-time.After(5 * time.Second):
+		t.Fatal("the filestream was blocked by a sink")
+	}
+
+	close(sink.release)
+	fs.Close()
+
+	assert.Len(t, historyOf(t, client.received(), 0), lines)
+	// each write carries the total of the lines dropped so far
+	var written, dropped int
+	for _, data := range sink.writes {
+		written += len(data.Files[HistoryFileName].Content)
+		dropped = int(data.Dropped)
+	}
+	assert.Positive(t, dropped)
+	assert.Equal(t, lines, written+dropped)
+}
+
+func TestFileSinkRewritesOutput(t *testing.T) {
+	dir := t.TempDir()
+	sink := NewFileSink(dir)
+	write := func(offset int, lines ...string) {
+		err := sink.Write(&FsTransmitData{Files: map[string]fsTransmitFileData{
+			OutputFileName: {Offset: offset, Content: lines},
+		}})
+		assert.NoError(t, err)
+	}
+
+	write(0, "a", "b 10%", "c")
+	write(1, "b 100%")
+	write(3, "d")
+	write(2, "C", "D", "e")
+	assert.NoError(t, sink.Close())
+
+	output, err := os.ReadFile(filepath.Join(dir, OutputFileName))
+	assert.NoError(t, err)
+	assert.Equal(t, "a\nb 100%\nC\nD\ne\n", string(output))
+}
+
+func TestFileSinkKeepsRunsApart(t *testing.T) {
+	dir := t.TempDir()
+	for _, run := range []string{"run1", "run2"} {
+		sink := NewFileSink(dir)
+		sink.SetPath("files/entity/project/" + run + "/file_stream")
+		err := sink.Write(&FsTransmitData{Files: map[string]fsTransmitFileData{
+			HistoryFileName: {Offset: 0, Content: []string{run}},
+		}})
+		assert.NoError(t, err)
+		assert.NoError(t, sink.Close())
+	}
+
+	for _, run := range []string{"run1", "run2"} {
+		history, err := os.ReadFile(
+			filepath.Join(dir, "entity", "project", run, HistoryFileName))
+		assert.NoError(t, err)
+		assert.Equal(t, run+"\n", string(history))
+	}
+	_, err := os.Stat(filepath.Join(dir, HistoryFileName))
+	assert.True(t, os.IsNotExist(err))
+}
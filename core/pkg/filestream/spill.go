@@ -0,0 +1,253 @@
+package filestream
+
+import (
+	"bufio"
+	"bytes"
+	"errors"
+	"io"
+	"os"
+	"path/filepath"
+
+	"github.com/segmentio/encoding/json"
+)
+
+// SpillFileName is the file in which requests that could not be sent are
+// kept until they can be.
+const SpillFileName = "filestream-spill.jsonl"
+
+// spillQueue is a queue of filestream requests that could not be sent.
+//
+// The requests are kept as the JSON that was sent, so that they are sent
+// again with the offsets they were given and the server sees the lines of
+// each file in order.
+//
+// The queue is a file with a request per line, or in memory if there is no
+// directory to put the file in. The first line of the file is a header with
+// the run the requests are for. A file left over by an earlier process that
+// stopped before it could send its requests is where the queue starts, so
+// that those requests are sent first if they are for the same run.
+type spillQueue struct {
+	// path is the file with the queue, empty to keep it in memory
+	path string
+
+	// memory holds the queue when there is no file
+	memory [][]byte
+
+	// header describes the requests in the queue, nil if the queue is
+	// empty or the file was written without one
+	header *spillHeader
+
+	// len is the number of requests in the queue
+	len int
+}
+
+// spillHeader is the run that the requests in a spill queue are for.
+type spillHeader struct {
+	// Path is the filestream path of the run
+	Path string `json:"path"`
+
+	// Offsets are the offsets of the run's files before the first request
+	// in the queue, by file name
+	Offsets map[string]int `json:"offsets"`
+}
+
+// advance moves the offsets of the header past a request.
+func (h *spillHeader) advance(request []byte) {
+	var data FsTransmitData
+	if err := json.Unmarshal(request, &data); err != nil {
+		return
+	}
+	for name, file := range data.Files {
+		// lines before the end of a file replace the lines there
+		if end := file.Offset + len(file.Content); end > h.Offsets[name] {
+			h.Offsets[name] = end
+		}
+	}
+}
+
+func newSpillQueue(dir string) *spillQueue {
+	q := &spillQueue{}
+	if dir != "" {
+		q.path = filepath.Join(dir, SpillFileName)
+		// an unreadable file is still appended to, and read again later
+		header, requests, _ := q.load()
+		q.header = header
+		q.len = len(requests)
+	}
+	return q
+}
+
+// push adds a request to the end of the queue.
+//
+// The header is that of the queue if the queue is empty.
+func (q *spillQueue) push(request []byte, header spillHeader) error {
+	if q.len == 0 {
+		q.header = &header
+	}
+	if q.path == "" {
+		q.memory = append(q.memory, request)
+		q.len++
+		return nil
+	}
+
+	flag := os.O_WRONLY | os.O_CREATE | os.O_APPEND
+	var line []byte
+	if q.len == 0 {
+		headerLine, err := json.Marshal(q.header)
+		if err != nil {
+			return err
+		}
+		flag = os.O_WRONLY | os.O_CREATE | os.O_TRUNC
+		line = append(headerLine, '\n')
+	}
+	f, err := os.OpenFile(q.path, flag, 0644)
+	if err != nil {
+		return err
+	}
+	line = append(append(line, request...), '\n')
+	_, err = f.Write(line)
+	if err = errors.Join(err, f.Close()); err != nil {
+		return err
+	}
+	q.len++
+	return nil
+}
+
+// drain sends the requests in the queue in order until send fails.
+//
+// The requests that were sent are removed from the queue. It returns the
+// number of requests sent and the error of the first request that failed.
+func (q *spillQueue) drain(send func([]byte) error) (int, error) {
+	_, requests, err := q.load()
+	if err != nil {
+		return 0, err
+	}
+
+	sent := 0
+	var sendErr error
+	for _, request := range requests {
+		if sendErr = send(request); sendErr != nil {
+			break
+		}
+		if q.header != nil {
+			q.header.advance(request)
+		}
+		sent++
+	}
+
+	if err := q.store(requests[sent:]); err != nil {
+		return sent, errors.Join(sendErr, err)
+	}
+	return sent, sendErr
+}
+
+// offsetsAfter returns the offsets of the run's files after the requests
+// in the queue, by file name, or nil if the queue has no header.
+func (q *spillQueue) offsetsAfter() (map[string]int, error) {
+	header, requests, err := q.load()
+	if err != nil || header == nil {
+		return nil, err
+	}
+	for _, request := range requests {
+		header.advance(request)
+	}
+	return header.Offsets, nil
+}
+
+// load returns the header and the requests in the queue.
+//
+// The header is a copy, so that it can be changed without changing the
+// queue.
+func (q *spillQueue) load() (*spillHeader, [][]byte, error) {
+	if q.path == "" {
+		return q.header.copy(), q.memory, nil
+	}
+
+	f, err := os.Open(q.path)
+	if os.IsNotExist(err) {
+		return nil, nil, nil
+	}
+	if err != nil {
+		return nil, nil, err
+	}
+	defer f.Close()
+
+	var header *spillHeader
+	var requests [][]byte
+	reader := bufio.NewReader(f)
+	for first := true; ; first = false {
+		line, err := reader.ReadBytes('\n')
+		if line = bytes.TrimSuffix(line, []byte("\n")); len(line) > 0 {
+			if first {
+				header = parseSpillHeader(line)
+			}
+			if header == nil || !first {
+				requests = append(requests, line)
+			}
+		}
+		if err == io.EOF {
+			return header, requests, nil
+		}
+		if err != nil {
+			return nil, nil, err
+		}
+	}
+}
+
+// store replaces the requests in the queue.
+func (q *spillQueue) store(requests [][]byte) error {
+	q.len = len(requests)
+	if q.len == 0 {
+		q.header = nil
+	}
+	if q.path == "" {
+		q.memory = requests
+		return nil
+	}
+
+	if len(requests) == 0 {
+		if err := os.Remove(q.path); err != nil && !os.IsNotExist(err) {
+			return err
+		}
+		return nil
+	}
+
+	// write the remaining requests next to the queue and swap them in, so
+	// that the queue is never lost halfway
+	tmpPath := q.path + ".tmp"
+	data := bytes.Join(requests, []byte("\n"))
+	if q.header != nil {
+		headerLine, err := json.Marshal(q.header)
+		if err != nil {
+			return err
+		}
+		data = append(append(headerLine, '\n'), data...)
+	}
+	if err := os.WriteFile(tmpPath, append(data, '\n'), 0644); err != nil {
+		return err
+	}
+	return os.Rename(tmpPath, q.path)
+}
+
+// copy returns a copy of the header, or nil if h is nil.
+func (h *spillHeader) copy() *spillHeader {
+	if h == nil {
+		return nil
+	}
+	offsets := make(map[string]int, len(h.Offsets))
+	for name, offset := range h.Offsets {
+		offsets[name] = offset
+	}
+	return &spillHeader{Path: h.Path, Offsets: offsets}
+}
+
+// parseSpillHeader returns the header in the first line of a spill file,
+// or nil if the line is a request.
+func parseSpillHeader(line []byte) *spillHeader {
+	var header spillHeader
+	// requests have no offsets at the top level
+	if err := json.Unmarshal(line, &header); err != nil || header.Offsets == nil {
+		return nil
+	}
+	return &header
+}
@@ -0,0 +1,367 @@
+package filestream
+
+import (
+	"errors"
+	"io"
+	"net/http"
+	"os"
+	"path/filepath"
+	"strings"
+	"sync"
+	"testing"
+	"time"
+
+	"github.com/segmentio/encoding/json"
+	"github.com/stretchr/testify/assert"
+
+	"github.com/wandb/wandb/core/internal/api"
+	"github.com/wandb/wandb/core/pkg/observability"
+	"github.com/wandb/wandb/core/pkg/service"
+)
+
+// flakyClient is an api.Client whose requests fail while it is offline.
+//
+// If status is set, the client answers every request with it.
+type flakyClient struct {
+	mu       sync.Mutex
+	offline  bool
+	status   int
+	requests []FsTransmitData
+}
+
+func (c *flakyClient) setOffline(offline bool) {
+	c.mu.Lock()
+	defer c.mu.Unlock()
+	c.offline = offline
+}
+
+func (c *flakyClient) received() []FsTransmitData {
+	c.mu.Lock()
+	defer c.mu.Unlock()
+	return append([]FsTransmitData{}, c.requests...)
+}
+
+func (c *flakyClient) Send(req *api.Request) (*http.Response, error) {
+	c.mu.Lock()
+	defer c.mu.Unlock()
+	if c.offline {
+		return nil, errors.New("connection refused")
+	}
+	if c.status != 0 {
+		return &http.Response{
+			StatusCode: c.status,
+			Status:     http.StatusText(c.status),
+			Body:       io.NopCloser(strings.NewReader("")),
+		}, nil
+	}
+
+	data := FsTransmitData{}
+	if err := json.Unmarshal(req.Body, &data); err != nil {
+		return nil, err
+	}
+	if len(data.Files) > 0 {
+		c.requests = append(c.requests, data)
+	}
+	return &http.Response{
+		StatusCode: http.StatusOK,
+		Body:       io.NopCloser(strings.NewReader("{}")),
+	}, nil
+}
+
+func (c *flakyClient) Do(req *http.Request) (*http.Response, error) {
+	return nil, errors.New("not implemented")
+}
+
+func historyLine(line string) processedChunk {
+	return processedChunk{fileType: HistoryChunk, fileLine: line}
+}
+
+func TestSpillQueue(t *testing.T) {
+	for _, dir := range []string{"", t.TempDir()} {
+		q := newSpillQueue(dir)
+		header := spillHeader{Offsets: map[string]int{}}
+		assert.NoError(t, q.push([]byte(`{"n":1}`), header))
+		assert.NoError(t, q.push([]byte(`{"n":2}`), header))
+		assert.NoError(t, q.push([]byte(`{"n":3}`), header))
+
+		var sent []string
+		n, err := q.drain(func(request []byte) error {
+			if string(request) == `{"n":2}` && len(sent) == 1 {
+				return errors.New("offline")
+			}
+			sent = append(sent, string(request))
+			return nil
+		})
+		assert.Error(t, err)
+		assert.Equal(t, 1, n)
+		assert.Equal(t, 2, q.len)
+
+		n, err = q.drain(func(request []byte) error {
+			sent = append(sent, string(request))
+			return nil
+		})
+		assert.NoError(t, err)
+		assert.Equal(t, 2, n)
+		assert.Equal(t, 0, q.len)
+		assert.Equal(t, []string{`{"n":1}`, `{"n":2}`, `{"n":3}`}, sent)
+
+		if dir != "" {
+			_, err := os.Stat(filepath.Join(dir, SpillFileName))
+			assert.True(t, os.IsNotExist(err))
+		}
+	}
+}
+
+func TestSpillQueueLeftoverFile(t *testing.T) {
+	dir := t.TempDir()
+	assert.NoError(t, os.WriteFile(
+		filepath.Join(dir, SpillFileName),
+		[]byte("{\"path\":\"run\",\"offsets\":{}}\n{\"n\":1}\n{\"n\":2}\n"),
+		0644,
+	))
+
+	// the requests of an earlier process are sent before the new ones
+	q := newSpillQueue(dir)
+	assert.Equal(t, 2, q.len)
+	assert.Equal(t, "run", q.header.Path)
+	assert.NoError(t, q.push([]byte(`{"n":3}`), spillHeader{Path: "other"}))
+
+	var sent []string
+	n, err := q.drain(func(request []byte) error {
+		sent = append(sent, string(request))
+		return nil
+	})
+	assert.NoError(t, err)
+	assert.Equal(t, 3, n)
+	assert.Equal(t, []string{`{"n":1}`, `{"n":2}`, `{"n":3}`}, sent)
+}
+
+// leftoverFileStream returns a filestream for the run at path with a spill
+// file left over by another process.
+func leftoverFileStream(
+	t *testing.T,
+	leftover string,
+	path string,
+	offsets FileStreamOffsetMap,
+) (*fileStream, *flakyClient) {
+	dir := t.TempDir()
+	assert.NoError(t, os.WriteFile(
+		filepath.Join(dir, SpillFileName),
+		[]byte(leftover),
+		0644,
+	))
+
+	client := &flakyClient{}
+	fs := NewFileStream(FileStreamParams{
+		Settings:     &service.Settings{},
+		Logger:       observability.NewNoOpLogger(),
+		ApiClient:    client,
+		DelayProcess: time.Millisecond,
+		PollInterval: time.Millisecond,
+		SpillDir:     dir,
+	}).(*fileStream)
+	fs.SetPath(path)
+	fs.SetOffsets(offsets)
+	return fs, client
+}
+
+func TestLeftoverRequestsAreSentFirst(t *testing.T) {
+	fs, client := leftoverFileStream(t,
+		`{"path":"run","offsets":{"wandb-history.jsonl":2}}`+"\n"+
+			`{"files":{"wandb-history.jsonl":{"offset":2,"content":["c","d"]}}}`+"\n",
+		"run",
+		FileStreamOffsetMap{HistoryChunk: 2},
+	)
+	fs.Start()
+	fs.addTransmit(historyLine("e"))
+	fs.Close()
+
+	// the new lines go after the ones left over
+	requests := client.received()
+	assert.Len(t, requests, 2)
+	assert.Equal(t, 2, requests[0].Files[HistoryFileName].Offset)
+	assert.Equal(t, 4, requests[1].Files[HistoryFileName].Offset)
+	assert.Equal(t, []string{"e"}, requests[1].Files[HistoryFileName].Content)
+}
+
+func TestLeftoverRequestsForOtherRunsAreDropped(t *testing.T) {
+	request := `{"files":{"wandb-history.jsonl":{"offset":2,"content":["c"]}}}`
+	testCases := []struct {
+		name     string
+		leftover string
+	}{
+		{"without header", request + "\n"},
+		{"other path", `{"path":"other","offsets":{"wandb-history.jsonl":2}}` +
+			"\n" + request + "\n"},
+		{"other offsets", `{"path":"run","offsets":{"wandb-history.jsonl":1}}` +
+			"\n" + request + "\n"},
+	}
+	for _, tc := range testCases {
+		t.Run(tc.name, func(t *testing.T) {
+			fs, client := leftoverFileStream(t,
+				tc.leftover,
+				"run",
+				FileStreamOffsetMap{HistoryChunk: 2},
+			)
+			fs.Start()
+			fs.addTransmit(historyLine("x"))
+			fs.Close()
+
+			requests := client.received()
+			assert.Len(t, requests, 1)
+			assert.Equal(t, 2, requests[0].Files[HistoryFileName].Offset)
+			assert.Equal(t, []string{"x"}, requests[0].Files[HistoryFileName].Content)
+		})
+	}
+}
+
+func TestSpilledRequestIsGivenUp(t *testing.T) {
+	fs := NewFileStream(FileStreamParams{
+		Settings:  &service.Settings{},
+		Logger:    observability.NewNoOpLogger(),
+		ApiClient: &flakyClient{offline: true},
+		SpillDir:  t.TempDir(),
+	}).(*fileStream)
+	var errs []error
+	fs.SetErrorHandler(func(err error) { errs = append(errs, err) })
+
+	fs.spillRequest([]byte(`{"files":{}}`), FileStreamOffsetMap{})
+	fs.spillRequest([]byte(`{"files":{}}`), FileStreamOffsetMap{})
+	for i := 0; i < maxSpillAttempts; i++ {
+		assert.False(t, fs.retrySpilled(true))
+	}
+
+	// only the first request was given up on
+	assert.Equal(t, 1, fs.spill.len)
+	assert.Len(t, errs, 1)
+}
+
+func TestSendSpillsWhileOffline(t *testing.T) {
+	dir := t.TempDir()
+	client := &flakyClient{offline: true}
+	peeker := observability.NewPeeker()
+	fs := NewFileStream(FileStreamParams{
+		Settings:           &service.Settings{},
+		Logger:             observability.NewNoOpLogger(),
+		ApiClient:          client,
+		DelayProcess:       time.Millisecond,
+		PollInterval:       time.Millisecond,
+		SpillDir:           dir,
+		SpillRetryInterval: time.Millisecond,
+		NetworkStatus:      peeker,
+	}).(*fileStream)
+	fs.Start()
+
+	fs.addTransmit(historyLine("a"))
+	assert.Eventually(t, func() bool {
+		_, err := os.Stat(filepath.Join(dir, SpillFileName))
+		return err == nil
+	}, time.Second, time.Millisecond)
+	fs.addTransmit(historyLine("b"))
+
+	client.setOffline(false)
+	fs.addTransmit(historyLine("c"))
+	fs.Close()
+
+	// the lines arrive in order and at the offsets they were given
+	var lines []string
+	offset := 0
+	for _, request := range client.received() {
+		file := request.Files[HistoryFileName]
+		assert.Equal(t, offset, file.Offset)
+		offset += len(file.Content)
+		lines = append(lines, file.Content...)
+	}
+	assert.Equal(t, []string{"a", "b", "c"}, lines)
+
+	_, err := os.Stat(filepath.Join(dir, SpillFileName))
+	assert.True(t, os.IsNotExist(err))
+
+	status := peeker.Read()
+	assert.GreaterOrEqual(t, len(status), 2)
+	assert.Contains(t, status[0].HttpResponseText, "Network issues")
+	assert.Contains(t, status[len(status)-1].HttpResponseText, "connection restored")
+}
+
+func TestDeliveredAfterSpilledRequestsAreSent(t *testing.T) {
+	dir := t.TempDir()
+	client := &flakyClient{offline: true}
+	fs := NewFileStream(FileStreamParams{
+		Settings:           &service.Settings{},
+		Logger:             observability.NewNoOpLogger(),
+		ApiClient:          client,
+		DelayProcess:       time.Millisecond,
+		PollInterval:       time.Millisecond,
+		SpillDir:           dir,
+		SpillRetryInterval: time.Millisecond,
+	}).(*fileStream)
+
+	mu := sync.Mutex{}
+	var delivered []int64
+	fs.SetDeliveredHandler(func(num int64) {
+		mu.Lock()
+		defer mu.Unlock()
+		delivered = append(delivered, num)
+	})
+	fs.Start()
+
+	history := func(num int64) *service.Record {
+		return &service.Record{
+			Num: num,
+			RecordType: &service.Record_History{History: &service.HistoryRecord{
+				Item: []*service.HistoryItem{{Key: "_step", ValueJson: "1"}},
+			}},
+		}
+	}
+	fs.StreamRecord(history(1))
+	assert.Eventually(t, func() bool {
+		_, err := os.Stat(filepath.Join(dir, SpillFileName))
+		return err == nil
+	}, time.Second, time.Millisecond)
+
+	mu.Lock()
+	assert.Empty(t, delivered)
+	mu.Unlock()
+
+	client.setOffline(false)
+	fs.StreamRecord(history(2))
+	fs.Close()
+
+	mu.Lock()
+	defer mu.Unlock()
+	assert.NotEmpty(t, delivered)
+	assert.Equal(t, int64(2), delivered[len(delivered)-1])
+}
+
+func TestRejectedRequestsAreReported(t *testing.T) {
+	dir := t.TempDir()
+	client := &flakyClient{status: http.StatusBadRequest}
+	fs := NewFileStream(FileStreamParams{
+		Settings:     &service.Settings{},
+		Logger:       observability.NewNoOpLogger(),
+		ApiClient:    client,
+		DelayProcess: time.Millisecond,
+		PollInterval: time.Millisecond,
+		SpillDir:     dir,
+	}).(*fileStream)
+
+	mu := sync.Mutex{}
+	var errs []error
+	fs.SetErrorHandler(func(err error) {
+		mu.Lock()
+		defer mu.Unlock()
+		errs = append(errs, err)
+	})
+	fs.Start()
+	fs.addTransmit(historyLine("a"))
+	fs.Close()
+
+	// the request is not retried from the spill file
+	_, err := os.Stat(filepath.Join(dir, SpillFileName))
+	assert.True(t, os.IsNotExist(err))
+
+	mu.Lock()
+	defer mu.Unlock()
+	assert.NotEmpty(t, errs)
+	assert.ErrorIs(t, errs[0], errRejected)
+}
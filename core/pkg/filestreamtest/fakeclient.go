@@ -0,0 +1,209 @@
+package filestreamtest
+
+import (
+	"bytes"
+	"cmp"
+	"compress/gzip"
+	"errors"
+	"fmt"
+	"io"
+	"net/http"
+	"slices"
+	"strings"
+	"sync"
+
+	"github.com/segmentio/encoding/json"
+
+	"github.com/wandb/wandb/core/internal/api"
+	"github.com/wandb/wandb/core/pkg/filestream"
+)
+
+// A fake filestream service, as an [api.Client] to pass to a FileStream.
+type FakeClient struct {
+	sync.Mutex
+
+	rejectGzip int
+	requests   []FakeRequest
+}
+
+// A request received by a [FakeClient].
+type FakeRequest struct {
+	Path string
+
+	// Gzipped is whether the body was compressed
+	Gzipped bool
+
+	// Size is the size of the body as sent
+	Size int
+
+	Data filestream.FsTransmitData
+}
+
+func NewFakeClient() *FakeClient {
+	return &FakeClient{}
+}
+
+// Prove that we implement the interface.
+var _ api.Client = &FakeClient{}
+
+// SetRejectGzip makes the fake reply to compressed requests with the
+// status, like servers that do not accept them, such as 415 Unsupported
+// Media Type. A zero status accepts them.
+func (c *FakeClient) SetRejectGzip(status int) {
+	c.Lock()
+	defer c.Unlock()
+	c.rejectGzip = status
+}
+
+// GetRequests returns the requests that were accepted.
+func (c *FakeClient) GetRequests() []FakeRequest {
+	c.Lock()
+	defer c.Unlock()
+	return slices.Clone(c.requests)
+}
+
+func (c *FakeClient) Send(req *api.Request) (*http.Response, error) {
+	c.Lock()
+	defer c.Unlock()
+
+	body := req.Body
+	gzipped := req.Headers["Content-Encoding"] == "gzip"
+	if gzipped {
+		if c.rejectGzip != 0 {
+			return c.reply(c.rejectGzip, `{"error":"unsupported encoding"}`), nil
+		}
+
+		r, err := gzip.NewReader(bytes.NewReader(body))
+		if err != nil {
+			return c.reply(http.StatusBadRequest, `{}`), nil
+		}
+		if body, err = io.ReadAll(r); err != nil {
+			return c.reply(http.StatusBadRequest, `{}`), nil
+		}
+	}
+
+	request := FakeRequest{Path: req.Path, Gzipped: gzipped, Size: len(req.Body)}
+	if err := json.Unmarshal(body, &request.Data); err != nil {
+		return c.reply(http.StatusBadRequest, `{}`), nil
+	}
+	c.requests = append(c.requests, request)
+	return c.reply(http.StatusOK, `{}`), nil
+}
+
+func (c *FakeClient) Do(req *http.Request) (*http.Response, error) {
+	return nil, errors.New("filestreamtest: Do is not supported")
+}
+
+func (c *FakeClient) reply(status int, body string) *http.Response {
+	header := http.Header{}
+	if status == http.StatusUnsupportedMediaType {
+		header.Set("Accept-Encoding", "identity")
+	}
+	return &http.Response{
+		StatusCode: status,
+		Header:     header,
+		Body:       io.NopCloser(strings.NewReader(body)),
+	}
+}
+
+// GetFileLines returns the lines of a file as the server would have them.
+//
+// Requests must give each file's lines at the offset where the previous ones
+// ended, except that output lines may replace earlier ones; an error is
+// returned for lines that would overwrite others or leave a gap, as happens
+// when writers of a shared run use the same offsets.
+func (c *FakeClient) GetFileLines(name string) ([]string, error) {
+	c.Lock()
+	defer c.Unlock()
+
+	canReplace := strings.HasPrefix(name, "output.")
+	var lines []string
+	for _, request := range c.requests {
+		file, ok := request.Data.Files[name]
+		if !ok {
+			continue
+		}
+		if file.Offset > len(lines) || file.Offset < len(lines) && !canReplace {
+			return lines, fmt.Errorf(
+				"filestreamtest: %s: lines at offset %d after %d lines",
+				name, file.Offset, len(lines),
+			)
+		}
+		for i, line := range file.Content {
+			if file.Offset+i < len(lines) {
+				lines[file.Offset+i] = line
+			} else {
+				lines = append(lines, line)
+			}
+		}
+	}
+	return lines, nil
+}
+
+// GetHistory returns the rows of the run's history merged from all
+// writers as the server does for the given merge.
+func (c *FakeClient) GetHistory(merge filestream.HistoryMerge) ([]map[string]any, error) {
+	c.Lock()
+	defer c.Unlock()
+
+	var rows []map[string]any
+	for _, request := range c.requests {
+		for _, line := range request.Data.Files[filestream.HistoryFileName].Content {
+			row := make(map[string]any)
+			if err := json.Unmarshal([]byte(line), &row); err != nil {
+				return nil, err
+			}
+			rows = append(rows, row)
+		}
+	}
+
+	switch merge {
+	case filestream.HistoryMergeServerStep:
+		for i, row := range rows {
+			row["_step"] = float64(i)
+		}
+		return rows, nil
+
+	case filestream.HistoryMergeStep:
+		byStep := make(map[float64]map[string]any)
+		for _, row := range rows {
+			step, ok := row["_step"].(float64)
+			if !ok {
+				return nil, errors.New("filestreamtest: history row without _step")
+			}
+			if byStep[step] == nil {
+				byStep[step] = make(map[string]any)
+			}
+			for key, value := range row {
+				byStep[step][key] = value
+			}
+		}
+		steps := make([]float64, 0, len(byStep))
+		for step := range byStep {
+			steps = append(steps, step)
+		}
+		slices.Sort(steps)
+		merged := make([]map[string]any, 0, len(steps))
+		for _, step := range steps {
+			merged = append(merged, byStep[step])
+		}
+		return merged, nil
+
+	case filestream.HistoryMergeTimestamp:
+		for _, row := range rows {
+			if _, ok := row["_timestamp"].(float64); !ok {
+				return nil, errors.New("filestreamtest: history row without _timestamp")
+			}
+		}
+		slices.SortStableFunc(rows, func(a, b map[string]any) int {
+			return cmp.Compare(a["_timestamp"].(float64), b["_timestamp"].(float64))
+		})
+		for i, row := range rows {
+			row["_step"] = float64(i)
+		}
+		return rows, nil
+
+	default:
+		return nil, fmt.Errorf("filestreamtest: unknown history merge %q", merge)
+	}
+}
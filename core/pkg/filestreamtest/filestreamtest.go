@@ -13,8 +13,12 @@ import (
 type FakeFileStream struct {
 	sync.Mutex
 
-	records       []*service.Record
-	filesUploaded []string
+	records         []*service.Record
+	output          []string
+	filesUploaded   []string
+	feedbackHandler func(filestream.Feedback)
+
+	deliveredHandler func(num int64)
 }
 
 func NewFakeFileStream() *FakeFileStream {
@@ -31,6 +35,14 @@ func (fs *FakeFileStream) GetRecords() []*service.Record {
 	return slices.Clone(fs.records)
 }
 
+// GetOutput returns the lines passed to `StreamOutput`, with lines that were
+// streamed again replaced.
+func (fs *FakeFileStream) GetOutput() []string {
+	fs.Lock()
+	defer fs.Unlock()
+	return slices.Clone(fs.output)
+}
+
 // GetFilesUploaded returns all invocations of `SignalFileUploaded`.
 func (fs *FakeFileStream) GetFilesUploaded() []string {
 	fs.Lock()
@@ -50,11 +62,56 @@ func (fs *FakeFileStream) Close()                                              {
 func (fs *FakeFileStream) SetPath(path string)                                 {}
 func (fs *FakeFileStream) SetOffsets(offsetMap filestream.FileStreamOffsetMap) {}
 
-func (fs *FakeFileStream) StreamRecord(rec *service.Record) {
+func (fs *FakeFileStream) SetFeedbackHandler(handler func(filestream.Feedback)) {
 	fs.Lock()
 	defer fs.Unlock()
 
+	fs.feedbackHandler = handler
+}
+
+// SendFeedback passes feedback to the handler set with `SetFeedbackHandler`,
+// as if the server had sent it.
+func (fs *FakeFileStream) SendFeedback(feedback filestream.Feedback) {
+	fs.Lock()
+	handler := fs.feedbackHandler
+	fs.Unlock()
+
+	if handler != nil {
+		handler(feedback)
+	}
+}
+
+func (fs *FakeFileStream) SetDeliveredHandler(handler func(num int64)) {
+	fs.Lock()
+	defer fs.Unlock()
+
+	fs.deliveredHandler = handler
+}
+
+func (fs *FakeFileStream) SetErrorHandler(handler func(err error)) {}
+
+// StreamRecord keeps the record and reports it as delivered right away.
+func (fs *FakeFileStream) StreamRecord(rec *service.Record) {
+	fs.Lock()
 	fs.records = append(fs.records, rec)
+	handler := fs.deliveredHandler
+	fs.Unlock()
+
+	if handler != nil && rec.GetNum() > 0 {
+		handler(rec.GetNum())
+	}
+}
+
+func (fs *FakeFileStream) StreamOutput(lines []filestream.OutputLine) {
+	fs.Lock()
+	defer fs.Unlock()
+
+	for _, line := range lines {
+		for len(fs.output) <= line.Num {
+			fs.output = append(fs.output, "")
+		}
+		fs.output[line.Num] = line.Content
+	}
 }
 
 func (fs *FakeFileStream) SignalFileUploaded(path string) {
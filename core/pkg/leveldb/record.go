@@ -2,6 +2,9 @@
 // https://github.com/golang/leveldb/blob/master/record/record.go
 // Changes:
 // - Add ability to use different CRC algorithm
+// - Track the offset of the last record returned by Reader.Next
+// - Expose the offset of the current block for corruption reports
+// - Report a record cut off at the end of the input as io.ErrUnexpectedEOF
 
 // Copyright 2011 The LevelDB-Go Authors. All rights reserved.
 // Use of this source code is governed by a BSD-style
@@ -130,6 +133,13 @@ type Reader struct {
 	// n is the number of bytes of buf that are valid. Once reading has started,
 	// only the final block can have n < blockSize.
 	n int
+	// blockOffset is the offset in r of the block currently held in buf.
+	blockOffset int64
+	// readOffset is the offset in r of the next block to be read into buf.
+	readOffset int64
+	// lastRecordOffset is the offset in r of the first chunk header of the
+	// record most recently returned by Next, or -1 if there is none.
+	lastRecordOffset int64
 	// started is whether Next has been called at all.
 	started bool
 	// recovering is true when recovering from corruption.
@@ -151,8 +161,9 @@ func NewReaderExt(r io.Reader, algo CRCAlgo) *Reader {
 		crc = CRCStandard
 	}
 	return &Reader{
-		r:   r,
-		crc: crc,
+		r:                r,
+		lastRecordOffset: -1,
+		crc:              crc,
 	}
 }
 
@@ -219,6 +230,8 @@ func (r *Reader) nextChunk(wantFirst bool) error {
 			return err
 		}
 		r.i, r.j, r.n = 0, 0, n
+		r.blockOffset = r.readOffset
+		r.readOffset += int64(n)
 	}
 }
 
@@ -236,9 +249,31 @@ func (r *Reader) Next() (io.Reader, error) {
 		return nil, r.err
 	}
 	r.started = true
+	r.lastRecordOffset = r.blockOffset + int64(r.i-headerSize)
 	return singleReader{r, r.seq}, nil
 }
 
+// LastRecordOffset returns the offset in the underlying io.Reader of the last
+// record returned by Next. It is the offset of the record's first chunk
+// header, the same value Writer.LastRecordOffset reported when the record was
+// written, and is suitable to pass to SeekRecord.
+//
+// If Next has not returned a record yet, LastRecordOffset returns
+// ErrNoLastRecord.
+func (r *Reader) LastRecordOffset() (int64, error) {
+	if r.lastRecordOffset < 0 {
+		return 0, ErrNoLastRecord
+	}
+	return r.lastRecordOffset, nil
+}
+
+// BlockOffset returns the offset in the underlying io.Reader of the block
+// the reader is currently in. After Next returns an error other than io.EOF,
+// it is the offset of the block in which the error was found.
+func (r *Reader) BlockOffset() int64 {
+	return r.blockOffset
+}
+
 // Recover clears any errors read so far, so that calling Next will start
 // reading from the next good 32KiB block. If there are no such blocks, Next
 // will return io.EOF. Recover also marks the current reader, the one most
@@ -290,6 +325,7 @@ func (r *Reader) SeekRecord(offset int64) error {
 	if _, r.err = s.Seek(offset&^blockSizeMask, io.SeekStart); r.err != nil {
 		return r.err
 	}
+	r.readOffset = offset &^ blockSizeMask
 
 	// Clear the state of the internal reader.
 	r.i, r.j, r.n = 0, 0, 0
@@ -323,6 +359,10 @@ func (x singleReader) Read(p []byte) (int, error) {
 			return 0, io.EOF
 		}
 		if r.err = r.nextChunk(false); r.err != nil {
+			if r.err == io.EOF {
+				// the input ends before the last chunk of the record
+				r.err = io.ErrUnexpectedEOF
+			}
 			return 0, r.err
 		}
 	}
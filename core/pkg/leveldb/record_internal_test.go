@@ -799,3 +799,58 @@ func TestNoLastRecordOffset(t *testing.T) {
 		t.Fatalf("LastRecordOffset: got %d, want 0", off)
 	}
 }
+
+func TestReaderLastRecordOffset(t *testing.T) {
+	recs, err := makeTestRecords(
+		// The first record will consume 3 entire blocks but a fraction of the 4th.
+		blockSize*3,
+		// The second record will completely fill the remainder of the 4th block.
+		3*(blockSize-headerSize)-2*blockSize-2*headerSize,
+		// Consume the entirety of the 5th block.
+		blockSize-headerSize,
+		// Consume roughly half of the 6th block.
+		blockSize/2,
+		// A few small records sharing the 6th block.
+		10,
+		20,
+	)
+	if err != nil {
+		t.Fatalf("makeTestRecords: %v", err)
+	}
+
+	r := NewReader(bytes.NewReader(recs.buf))
+	if _, err := r.LastRecordOffset(); err != ErrNoLastRecord {
+		t.Fatalf("Expected ErrNoLastRecord, got: %v", err)
+	}
+
+	for i := range recs.records {
+		if _, err := r.Next(); err != nil {
+			t.Fatalf("Next: %v", err)
+		}
+		got, err := r.LastRecordOffset()
+		if err != nil {
+			t.Fatalf("LastRecordOffset: %v", err)
+		}
+		if want := recs.offsets[i]; got != want {
+			t.Errorf("record #%d: got %d, want %d", i, got, want)
+		}
+	}
+
+	// The offsets reported after seeking must match the writer's offsets.
+	for _, i := range []int{4, 1, 5} {
+		if err := r.SeekRecord(recs.offsets[i]); err != nil {
+			t.Fatalf("SeekRecord: %v", err)
+		}
+		rec, err := r.Next()
+		if err != nil {
+			t.Fatalf("Next: %v", err)
+		}
+		rData, _ := io.ReadAll(rec)
+		if !bytes.Equal(rData, recs.records[i]) {
+			t.Fatalf("Unexpected output in record #%d's data", i)
+		}
+		if got, _ := r.LastRecordOffset(); got != recs.offsets[i] {
+			t.Errorf("record #%d after seek: got %d, want %d", i, got, recs.offsets[i])
+		}
+	}
+}
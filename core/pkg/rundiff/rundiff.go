@@ -0,0 +1,578 @@
+// Package rundiff compares the transaction logs of two runs.
+//
+// It is meant for debugging: to compare two runs that should be the same,
+// or the log of a run before and after it was synced. The records of both
+// logs are aligned by type, and history rows by step, so that the
+// differences do not depend on how the records happened to be ordered.
+package rundiff
+
+import (
+	"context"
+	"fmt"
+	"io"
+	"os"
+	"sort"
+	"strconv"
+	"strings"
+
+	"github.com/segmentio/encoding/json"
+	"google.golang.org/protobuf/proto"
+
+	"github.com/wandb/wandb/core/internal/runconfig"
+	"github.com/wandb/wandb/core/pkg/observability"
+	"github.com/wandb/wandb/core/pkg/server"
+	"github.com/wandb/wandb/core/pkg/service"
+)
+
+// Section is the part of a run a Difference is in.
+type Section string
+
+const (
+	SectionConfig   Section = "config"
+	SectionSummary  Section = "summary"
+	SectionMetric   Section = "metric"
+	SectionHistory  Section = "history"
+	SectionArtifact Section = "artifact"
+)
+
+// sectionOrder is the order of the sections in a Result.
+var sectionOrder = map[Section]int{
+	SectionConfig:   0,
+	SectionSummary:  1,
+	SectionMetric:   2,
+	SectionHistory:  3,
+	SectionArtifact: 4,
+}
+
+// Kind is how a value differs between the logs.
+type Kind string
+
+const (
+	// Removed values are only in the left log
+	Removed Kind = "removed"
+
+	// Added values are only in the right log
+	Added Kind = "added"
+
+	// Changed values are in both logs with different values
+	Changed Kind = "changed"
+)
+
+// Difference is a value that is not the same in both logs.
+type Difference struct {
+	Section Section `json:"section"`
+
+	// Key is the dotted path of a config, summary or history value, the
+	// name of a metric, or the use, type, name and digest or ID of an
+	// artifact
+	//
+	// It is empty for history rows that are only in one of the logs.
+	Key string `json:"key,omitempty"`
+
+	// Step is the step of a history value
+	Step *int64 `json:"step,omitempty"`
+
+	Kind Kind `json:"kind"`
+
+	// Left is the value in the left log, as JSON for config, summary and
+	// history values
+	Left string `json:"left,omitempty"`
+
+	// Right is the value in the right log
+	Right string `json:"right,omitempty"`
+}
+
+// LogInfo describes one of the compared logs.
+type LogInfo struct {
+	Path string `json:"path"`
+
+	// Records is the number of records read from the log
+	Records int `json:"records"`
+
+	// Corrupt is the number of records that could not be read
+	Corrupt int `json:"corrupt"`
+}
+
+// Result is the outcome of comparing two logs.
+type Result struct {
+	Left  LogInfo `json:"left"`
+	Right LogInfo `json:"right"`
+
+	// Differences is sorted by section, key and step
+	Differences []Difference `json:"differences"`
+}
+
+// Diff compares the transaction logs at leftFile and rightFile.
+//
+// Corrupt records are skipped and counted in the Result, like in export.
+func Diff(
+	ctx context.Context,
+	logger *observability.CoreLogger,
+	leftFile string,
+	rightFile string,
+) (*Result, error) {
+	left, err := readLog(ctx, logger, leftFile)
+	if err != nil {
+		return nil, err
+	}
+	right, err := readLog(ctx, logger, rightFile)
+	if err != nil {
+		return nil, err
+	}
+
+	result := &Result{
+		Left:        left.info,
+		Right:       right.info,
+		Differences: []Difference{},
+	}
+	result.compareValues(SectionConfig, left.config, right.config)
+	result.compareValues(SectionSummary, left.summary, right.summary)
+	result.compareValues(SectionMetric, left.metrics, right.metrics)
+	result.compareHistory(left, right)
+	result.compareValues(SectionArtifact, left.artifacts, right.artifacts)
+
+	sort.Slice(result.Differences, func(i, j int) bool {
+		a, b := result.Differences[i], result.Differences[j]
+		if a.Section != b.Section {
+			return sectionOrder[a.Section] < sectionOrder[b.Section]
+		}
+		if a.Key != b.Key {
+			return a.Key < b.Key
+		}
+		// a history key that is only in one of the logs comes before the
+		// values of the key
+		if a.Step == nil || b.Step == nil {
+			return a.Step == nil && b.Step != nil
+		}
+		return *a.Step < *b.Step
+	})
+	return result, nil
+}
+
+// compareValues adds the differences between two sets of values.
+func (r *Result) compareValues(section Section, left, right map[string]string) {
+	for key, leftValue := range left {
+		rightValue, ok := right[key]
+		switch {
+		case !ok:
+			r.add(Difference{Section: section, Key: key, Kind: Removed, Left: leftValue})
+		case leftValue != rightValue:
+			r.add(Difference{
+				Section: section,
+				Key:     key,
+				Kind:    Changed,
+				Left:    leftValue,
+				Right:   rightValue,
+			})
+		}
+	}
+	for key, rightValue := range right {
+		if _, ok := left[key]; !ok {
+			r.add(Difference{Section: section, Key: key, Kind: Added, Right: rightValue})
+		}
+	}
+}
+
+// compareHistory adds the differences between the history of two logs.
+//
+// A key that is only logged in one of the logs is reported once rather
+// than at every step, and so is a step that is only in one of the logs.
+func (r *Result) compareHistory(left, right *runLog) {
+	r.compareKeys(left.historyKeys, right.historyKeys)
+
+	for step, leftRow := range left.history {
+		step := step
+		rightRow, ok := right.history[step]
+		if !ok {
+			r.add(Difference{Section: SectionHistory, Step: &step, Kind: Removed})
+			continue
+		}
+
+		for key, leftValue := range leftRow {
+			if !right.historyKeys[key] {
+				continue
+			}
+			rightValue, ok := rightRow[key]
+			switch {
+			case !ok:
+				r.add(Difference{
+					Section: SectionHistory,
+					Key:     key,
+					Step:    &step,
+					Kind:    Removed,
+					Left:    leftValue,
+				})
+			case leftValue != rightValue:
+				r.add(Difference{
+					Section: SectionHistory,
+					Key:     key,
+					Step:    &step,
+					Kind:    Changed,
+					Left:    leftValue,
+					Right:   rightValue,
+				})
+			}
+		}
+		for key, rightValue := range rightRow {
+			if _, ok := leftRow[key]; !ok && left.historyKeys[key] {
+				r.add(Difference{
+					Section: SectionHistory,
+					Key:     key,
+					Step:    &step,
+					Kind:    Added,
+					Right:   rightValue,
+				})
+			}
+		}
+	}
+	for step := range right.history {
+		step := step
+		if _, ok := left.history[step]; !ok {
+			r.add(Difference{Section: SectionHistory, Step: &step, Kind: Added})
+		}
+	}
+}
+
+// compareKeys adds the history keys that are only in one of the logs.
+func (r *Result) compareKeys(left, right map[string]bool) {
+	for key := range left {
+		if !right[key] {
+			r.add(Difference{Section: SectionHistory, Key: key, Kind: Removed})
+		}
+	}
+	for key := range right {
+		if !left[key] {
+			r.add(Difference{Section: SectionHistory, Key: key, Kind: Added})
+		}
+	}
+}
+
+func (r *Result) add(difference Difference) {
+	r.Differences = append(r.Differences, difference)
+}
+
+// WriteJSON writes the result as an indented JSON object.
+func (r *Result) WriteJSON(w io.Writer) error {
+	data, err := json.MarshalIndent(r, "", "  ")
+	if err != nil {
+		return err
+	}
+	_, err = fmt.Fprintf(w, "%s\n", data)
+	return err
+}
+
+// WriteText writes the result in a format similar to a unified diff: a
+// line per difference, prefixed with "-" for values only in the left log,
+// "+" for values only in the right log and "~" for changed values.
+func (r *Result) WriteText(w io.Writer) error {
+	var b strings.Builder
+	fmt.Fprintf(&b, "--- %s\n+++ %s\n", r.Left.Path, r.Right.Path)
+	for _, info := range []LogInfo{r.Left, r.Right} {
+		if info.Corrupt > 0 {
+			fmt.Fprintf(&b, "# %s: skipped %d corrupt records\n", info.Path, info.Corrupt)
+		}
+	}
+	if len(r.Differences) == 0 {
+		b.WriteString("no differences\n")
+	}
+
+	for _, d := range r.Differences {
+		name := string(d.Section)
+		if d.Step != nil {
+			name += " step " + strconv.FormatInt(*d.Step, 10)
+		}
+		if d.Key != "" {
+			name += " " + d.Key
+		}
+
+		switch d.Kind {
+		case Removed:
+			fmt.Fprintf(&b, "- %s", name)
+			if d.Left != "" {
+				fmt.Fprintf(&b, ": %s", d.Left)
+			}
+		case Added:
+			fmt.Fprintf(&b, "+ %s", name)
+			if d.Right != "" {
+				fmt.Fprintf(&b, ": %s", d.Right)
+			}
+		case Changed:
+			fmt.Fprintf(&b, "~ %s: %s -> %s", name, d.Left, d.Right)
+		}
+		b.WriteString("\n")
+	}
+
+	_, err := io.WriteString(w, b.String())
+	return err
+}
+
+// runLog is the state of a run as read from its log.
+type runLog struct {
+	logger *observability.CoreLogger
+
+	info LogInfo
+
+	// runConfig is the config built from the run and config records
+	runConfig *runconfig.RunConfig
+
+	// config maps the paths of the config values to their JSON
+	config map[string]string
+
+	// summary maps the paths of the summary values to their JSON
+	summary map[string]string
+
+	// metricRecords are the metric definitions by name or glob
+	metricRecords map[string]*service.MetricRecord
+
+	// metrics maps metric names to a description of their definition
+	metrics map[string]string
+
+	// history maps steps to the paths and JSON of the values logged at
+	// the step
+	history map[int64]map[string]string
+
+	// historyKeys has the keys logged at any step
+	historyKeys map[string]bool
+
+	// nextStep is the step of a history record without one
+	nextStep int64
+
+	// artifacts maps the versions of the artifacts logged and used to the
+	// aliases of the logged ones
+	//
+	// Logged artifacts are keyed by their digest and used artifacts by
+	// their ID, so that every version of an artifact is compared.
+	artifacts map[string]string
+}
+
+func readLog(
+	ctx context.Context,
+	logger *observability.CoreLogger,
+	syncFile string,
+) (*runLog, error) {
+	store := server.NewStore(ctx, syncFile, logger)
+	if err := store.Open(os.O_RDONLY); err != nil {
+		return nil, err
+	}
+	defer store.Close()
+
+	l := &runLog{
+		logger:        logger,
+		info:          LogInfo{Path: syncFile},
+		runConfig:     runconfig.New(),
+		config:        make(map[string]string),
+		summary:       make(map[string]string),
+		metricRecords: make(map[string]*service.MetricRecord),
+		metrics:       make(map[string]string),
+		history:       make(map[int64]map[string]string),
+		historyKeys:   make(map[string]bool),
+		artifacts:     make(map[string]string),
+	}
+
+	stats, err := store.Replay(0, 0, l.handleRecord)
+	l.info.Records = stats.Read
+	l.info.Corrupt = stats.Corrupt
+	if err != nil {
+		return nil, err
+	}
+
+	flatten("", l.runConfig.Tree(), l.config)
+	for name, metric := range l.metricRecords {
+		l.metrics[name] = describeMetric(metric)
+	}
+	return l, nil
+}
+
+func (l *runLog) handleRecord(record *service.Record) {
+	switch x := record.RecordType.(type) {
+	case *service.Record_Run:
+		l.handleConfig(x.Run.GetConfig())
+		l.handleSummary(x.Run.GetSummary())
+	case *service.Record_Config:
+		l.handleConfig(x.Config)
+	case *service.Record_Summary:
+		l.handleSummary(x.Summary)
+	case *service.Record_Metric:
+		l.handleMetric(x.Metric)
+	case *service.Record_History:
+		l.handleHistory(x.History)
+	case *service.Record_Artifact:
+		key := fmt.Sprintf("logged %s %s@%s",
+			x.Artifact.GetType(), x.Artifact.GetName(), x.Artifact.GetDigest())
+		aliases := append([]string{}, x.Artifact.GetAliases()...)
+		sort.Strings(aliases)
+		l.artifacts[key] = strings.Join(aliases, ",")
+	case *service.Record_UseArtifact:
+		key := fmt.Sprintf("used %s %s@%s",
+			x.UseArtifact.GetType(), x.UseArtifact.GetName(), x.UseArtifact.GetId())
+		l.artifacts[key] = ""
+	}
+}
+
+func (l *runLog) handleConfig(config *service.ConfigRecord) {
+	l.runConfig.ApplyChangeRecord(config, func(err error) {
+		l.logger.CaptureError("rundiff: error updating config", err)
+	})
+}
+
+func (l *runLog) handleSummary(summary *service.SummaryRecord) {
+	for _, item := range summary.GetUpdate() {
+		path := itemPath(item.GetKey(), item.GetNestedKey())
+		l.removePrefix(l.summary, path)
+		l.addValue(l.summary, path, item.GetValueJson())
+	}
+	for _, item := range summary.GetRemove() {
+		l.removePrefix(l.summary, itemPath(item.GetKey(), item.GetNestedKey()))
+	}
+}
+
+func (l *runLog) handleMetric(metric *service.MetricRecord) {
+	name := metric.GetName()
+	if name == "" {
+		name = metric.GetGlobName()
+	}
+
+	// later definitions of a metric update the earlier ones, like in the
+	// handler
+	definition := proto.Clone(metric).(*service.MetricRecord)
+	definition.XControl = nil
+	definition.XInfo = nil
+	if existing, ok := l.metricRecords[name]; ok {
+		proto.Merge(existing, definition)
+	} else {
+		l.metricRecords[name] = definition
+	}
+}
+
+func (l *runLog) handleHistory(history *service.HistoryRecord) {
+	step := l.nextStep
+	if history.GetStep() != nil {
+		step = history.GetStep().GetNum()
+	}
+
+	values := make(map[string]string)
+	for _, item := range history.GetItem() {
+		path := itemPath(item.GetKey(), item.GetNestedKey())
+		if path == "_step" {
+			if s, err := strconv.ParseInt(item.GetValueJson(), 10, 64); err == nil {
+				step = s
+			}
+		}
+		l.addValue(values, path, item.GetValueJson())
+	}
+	l.nextStep = step + 1
+
+	// rows logged at the same step are merged, like partial history
+	row, ok := l.history[step]
+	if !ok {
+		row = make(map[string]string)
+		l.history[step] = row
+	}
+	for key, value := range values {
+		row[key] = value
+		l.historyKeys[key] = true
+	}
+}
+
+// addValue adds the JSON value at path to values, flattening objects so
+// that their values are compared separately.
+func (l *runLog) addValue(values map[string]string, path string, valueJson string) {
+	var value any
+	if err := json.Unmarshal([]byte(valueJson), &value); err != nil {
+		l.logger.CaptureError("rundiff: error parsing value", err, "key", path)
+		values[path] = valueJson
+		return
+	}
+	flatten(path, value, values)
+}
+
+// removePrefix removes the value at path and the values nested in it.
+func (l *runLog) removePrefix(values map[string]string, path string) {
+	for key := range values {
+		if key == path || strings.HasPrefix(key, path+".") {
+			delete(values, key)
+		}
+	}
+}
+
+// itemPath returns the dotted path of a config, summary or history item.
+func itemPath(key string, nestedKey []string) string {
+	if len(nestedKey) > 0 {
+		return strings.Join(nestedKey, ".")
+	}
+	return key
+}
+
+// flatten adds the leaves of value to values by their dotted paths.
+//
+// The values are added as normalized JSON, so that the same values are
+// equal regardless of how they were formatted.
+func flatten(path string, value any, values map[string]string) {
+	if object, ok := value.(map[string]any); ok && len(object) > 0 {
+		for key, child := range object {
+			if path != "" {
+				key = path + "." + key
+			}
+			flatten(key, child, values)
+		}
+		return
+	}
+	if path == "" {
+		return
+	}
+
+	data, err := json.Marshal(value)
+	if err != nil {
+		values[path] = fmt.Sprint(value)
+		return
+	}
+	values[path] = string(data)
+}
+
+// describeMetric returns a stable description of a metric definition.
+func describeMetric(metric *service.MetricRecord) string {
+	var parts []string
+	if stepMetric := metric.GetStepMetric(); stepMetric != "" {
+		parts = append(parts, "step_metric="+stepMetric)
+	}
+	if metric.GetOptions().GetStepSync() {
+		parts = append(parts, "step_sync")
+	}
+	if metric.GetOptions().GetHidden() {
+		parts = append(parts, "hidden")
+	}
+
+	var summaries []string
+	summary := metric.GetSummary()
+	for _, s := range []struct {
+		name string
+		set  bool
+	}{
+		{"min", summary.GetMin()},
+		{"max", summary.GetMax()},
+		{"mean", summary.GetMean()},
+		{"best", summary.GetBest()},
+		{"last", summary.GetLast()},
+		{"none", summary.GetNone()},
+		{"copy", summary.GetCopy()},
+	} {
+		if s.set {
+			summaries = append(summaries, s.name)
+		}
+	}
+	if len(summaries) > 0 {
+		parts = append(parts, "summary="+strings.Join(summaries, ","))
+	}
+
+	switch metric.GetGoal() {
+	case service.MetricRecord_GOAL_MINIMIZE:
+		parts = append(parts, "goal=minimize")
+	case service.MetricRecord_GOAL_MAXIMIZE:
+		parts = append(parts, "goal=maximize")
+	}
+
+	if len(parts) == 0 {
+		return "defined"
+	}
+	return strings.Join(parts, " ")
+}
@@ -0,0 +1,184 @@
+package rundiff_test
+
+import (
+	"bytes"
+	"context"
+	"path/filepath"
+	"testing"
+
+	"github.com/segmentio/encoding/json"
+	"github.com/stretchr/testify/assert"
+
+	"github.com/wandb/wandb/core/pkg/observability"
+	"github.com/wandb/wandb/core/pkg/rundiff"
+	"github.com/wandb/wandb/core/pkg/service"
+	"github.com/wandb/wandb/core/pkg/storetest"
+)
+
+func configRecord(items ...*service.ConfigItem) *service.Record {
+	return &service.Record{RecordType: &service.Record_Config{
+		Config: &service.ConfigRecord{Update: items},
+	}}
+}
+
+func summaryRecord(items ...*service.SummaryItem) *service.Record {
+	return &service.Record{RecordType: &service.Record_Summary{
+		Summary: &service.SummaryRecord{Update: items},
+	}}
+}
+
+func historyRecord(items ...*service.HistoryItem) *service.Record {
+	return &service.Record{RecordType: &service.Record_History{
+		History: &service.HistoryRecord{Item: items},
+	}}
+}
+
+func metricRecord(metric *service.MetricRecord) *service.Record {
+	return &service.Record{RecordType: &service.Record_Metric{Metric: metric}}
+}
+
+func step(n int64) *int64 {
+	return &n
+}
+
+func TestDiff(t *testing.T) {
+	dir := t.TempDir()
+	left := filepath.Join(dir, "left.wandb")
+	right := filepath.Join(dir, "right.wandb")
+
+	storetest.WriteLog(t, left,
+		configRecord(
+			&service.ConfigItem{Key: "lr", ValueJson: "0.1"},
+			&service.ConfigItem{Key: "optimizer", ValueJson: `{"name": "adam", "beta": 0.9}`},
+		),
+		metricRecord(&service.MetricRecord{Name: "loss", Goal: service.MetricRecord_GOAL_MINIMIZE}),
+		historyRecord(
+			&service.HistoryItem{Key: "_step", ValueJson: "0"},
+			&service.HistoryItem{Key: "loss", ValueJson: "0.5"},
+			&service.HistoryItem{Key: "lr", ValueJson: "0.1"},
+		),
+		historyRecord(
+			&service.HistoryItem{Key: "_step", ValueJson: "1"},
+			&service.HistoryItem{Key: "loss", ValueJson: "0.25"},
+		),
+		historyRecord(
+			&service.HistoryItem{Key: "_step", ValueJson: "2"},
+			&service.HistoryItem{Key: "loss", ValueJson: "0.125"},
+		),
+		summaryRecord(&service.SummaryItem{Key: "loss", ValueJson: "0.125"}),
+		&service.Record{RecordType: &service.Record_UseArtifact{UseArtifact: &service.UseArtifactRecord{
+			Id:   "123",
+			Name: "dataset:v0",
+			Type: "dataset",
+		}}},
+		&service.Record{RecordType: &service.Record_Artifact{Artifact: &service.ArtifactRecord{
+			Name:    "model",
+			Type:    "model",
+			Digest:  "abc",
+			Aliases: []string{"latest"},
+		}}},
+	)
+
+	// the same run with records in another order, differently formatted
+	// values and some changes
+	storetest.WriteLog(t, right,
+		historyRecord(
+			&service.HistoryItem{Key: "_step", ValueJson: "1"},
+			&service.HistoryItem{Key: "loss", ValueJson: "0.3"},
+		),
+		historyRecord(
+			&service.HistoryItem{Key: "_step", ValueJson: "0"},
+			&service.HistoryItem{Key: "loss", ValueJson: "0.50"},
+			&service.HistoryItem{Key: "acc", ValueJson: "0.1"},
+		),
+		configRecord(
+			&service.ConfigItem{Key: "optimizer", ValueJson: `{"beta":0.99,"name":"adam"}`},
+			&service.ConfigItem{Key: "lr", ValueJson: "0.1"},
+		),
+		metricRecord(&service.MetricRecord{Name: "loss", Goal: service.MetricRecord_GOAL_MINIMIZE}),
+		metricRecord(&service.MetricRecord{Name: "acc", StepMetric: "_step"}),
+		summaryRecord(&service.SummaryItem{Key: "loss", ValueJson: "0.3"}),
+		&service.Record{RecordType: &service.Record_Artifact{Artifact: &service.ArtifactRecord{
+			Name:    "model",
+			Type:    "model",
+			Digest:  "abc",
+			Aliases: []string{"latest", "best"},
+		}}},
+		&service.Record{RecordType: &service.Record_Artifact{Artifact: &service.ArtifactRecord{
+			Name:    "model",
+			Type:    "model",
+			Digest:  "def",
+			Aliases: []string{"latest"},
+		}}},
+	)
+
+	result, err := rundiff.Diff(context.Background(), observability.NewNoOpLogger(), left, right)
+	assert.NoError(t, err)
+	assert.Equal(t, rundiff.LogInfo{Path: left, Records: 8}, result.Left)
+	assert.Equal(t, rundiff.LogInfo{Path: right, Records: 8}, result.Right)
+	assert.Equal(t, []rundiff.Difference{
+		{Section: rundiff.SectionConfig, Key: "optimizer.beta", Kind: rundiff.Changed, Left: "0.9", Right: "0.99"},
+		{Section: rundiff.SectionSummary, Key: "loss", Kind: rundiff.Changed, Left: "0.125", Right: "0.3"},
+		{Section: rundiff.SectionMetric, Key: "acc", Kind: rundiff.Added, Right: "step_metric=_step"},
+		{Section: rundiff.SectionHistory, Step: step(2), Kind: rundiff.Removed},
+		{Section: rundiff.SectionHistory, Key: "acc", Kind: rundiff.Added},
+		{Section: rundiff.SectionHistory, Key: "loss", Step: step(1), Kind: rundiff.Changed, Left: "0.25", Right: "0.3"},
+		{Section: rundiff.SectionHistory, Key: "lr", Kind: rundiff.Removed},
+		{Section: rundiff.SectionArtifact, Key: "logged model model@abc", Kind: rundiff.Changed, Left: "latest", Right: "best,latest"},
+		{Section: rundiff.SectionArtifact, Key: "logged model model@def", Kind: rundiff.Added, Right: "latest"},
+		{Section: rundiff.SectionArtifact, Key: "used dataset dataset:v0@123", Kind: rundiff.Removed},
+	}, result.Differences)
+
+	text := bytes.Buffer{}
+	assert.NoError(t, result.WriteText(&text))
+	assert.Equal(t,
+		"--- "+left+"\n"+
+			"+++ "+right+"\n"+
+			"~ config optimizer.beta: 0.9 -> 0.99\n"+
+			"~ summary loss: 0.125 -> 0.3\n"+
+			"+ metric acc: step_metric=_step\n"+
+			"- history step 2\n"+
+			"+ history acc\n"+
+			"~ history step 1 loss: 0.25 -> 0.3\n"+
+			"- history lr\n"+
+			"~ artifact logged model model@abc: latest -> best,latest\n"+
+			"+ artifact logged model model@def: latest\n"+
+			"- artifact used dataset dataset:v0@123\n",
+		text.String())
+
+	data := bytes.Buffer{}
+	assert.NoError(t, result.WriteJSON(&data))
+	var decoded rundiff.Result
+	assert.NoError(t, json.Unmarshal(data.Bytes(), &decoded))
+	assert.Equal(t, *result, decoded)
+}
+
+func TestDiffSame(t *testing.T) {
+	path := filepath.Join(t.TempDir(), "run.wandb")
+	storetest.WriteLog(t, path,
+		configRecord(&service.ConfigItem{Key: "lr", ValueJson: "0.1"}),
+		historyRecord(&service.HistoryItem{Key: "loss", ValueJson: "0.5"}),
+	)
+
+	result, err := rundiff.Diff(context.Background(), observability.NewNoOpLogger(), path, path)
+	assert.NoError(t, err)
+	assert.Empty(t, result.Differences)
+
+	text := bytes.Buffer{}
+	assert.NoError(t, result.WriteText(&text))
+	assert.Equal(t, "--- "+path+"\n+++ "+path+"\nno differences\n", text.String())
+}
+
+func TestDiffMissingFile(t *testing.T) {
+	dir := t.TempDir()
+	path := filepath.Join(dir, "run.wandb")
+	storetest.WriteLog(t, path)
+
+	_, err := rundiff.Diff(
+		context.Background(),
+		observability.NewNoOpLogger(),
+		path,
+		filepath.Join(dir, "missing.wandb"),
+	)
+	assert.Error(t, err)
+}
@@ -0,0 +1,241 @@
+package server
+
+import (
+	"fmt"
+	"os"
+	"path/filepath"
+	"sync"
+
+	"github.com/segmentio/encoding/json"
+
+	"github.com/wandb/wandb/core/pkg/service"
+)
+
+const (
+	// checkpointSuffix is appended to the transaction log name to get the
+	// name of its checkpoint file
+	checkpointSuffix = ".checkpoint"
+)
+
+// CheckpointState is the persisted part of a Checkpoint.
+type CheckpointState struct {
+	// Num is the number of the last record handled by the sender
+	Num int64 `json:"num"`
+
+	// Offset is the store offset of a record at or before Num
+	//
+	// Replaying from this offset and skipping the records up to Num
+	// resends exactly the records the sender did not handle.
+	Offset int64 `json:"offset"`
+
+	// RunOffset is the store offset of the first run record
+	RunOffset int64 `json:"run_offset"`
+
+	// Finished is whether the sender handled the whole run
+	Finished bool `json:"finished"`
+}
+
+// storedRecord is a record written to the store that the sender
+// has not handled yet
+type storedRecord struct {
+	num    int64
+	offset int64
+}
+
+// Checkpoint tracks the records of the transaction log that the sender
+// has handled, so that a crashed run can resume uploading where it stopped.
+//
+// The writer reports the offset of every record it stores and the sender
+// reports the number of every record it handles. The two run concurrently,
+// so records reported by the writer are kept until the sender catches up.
+//
+// Records the sender passes to the filestream are only handled once the
+// filestream reports that the server has their data, so that a crash
+// before then sends them again.
+type Checkpoint struct {
+	mu sync.Mutex
+
+	// path is the path of the checkpoint file
+	path string
+
+	// state is the current state of the checkpoint
+	state CheckpointState
+
+	// pending are the stored records not handled by the sender yet
+	pending []storedRecord
+
+	// handled is the number of the last record the sender handled
+	handled int64
+
+	// streamed are the numbers of the records passed to the filestream
+	// and not yet delivered, in order
+	streamed []int64
+
+	// runSeen is whether the offset of the first run record is known
+	runSeen bool
+}
+
+// CheckpointPath returns the path of the checkpoint file of a transaction log.
+func CheckpointPath(syncFile string) string {
+	return syncFile + checkpointSuffix
+}
+
+// NewCheckpoint returns a new checkpoint persisted at the given path.
+func NewCheckpoint(path string) *Checkpoint {
+	return &Checkpoint{path: path}
+}
+
+// LoadCheckpoint reads the checkpoint state persisted at the given path.
+func LoadCheckpoint(path string) (*CheckpointState, error) {
+	data, err := os.ReadFile(path)
+	if err != nil {
+		return nil, err
+	}
+
+	state := &CheckpointState{}
+	if err := json.Unmarshal(data, state); err != nil {
+		return nil, fmt.Errorf("checkpoint: failed to parse %s: %v", path, err)
+	}
+	return state, nil
+}
+
+// FindUnfinishedLogs returns the transaction logs in the run directories
+// under dir whose checkpoint shows the sender did not handle the whole run.
+//
+// Logs without a checkpoint are not returned: they either belong to an
+// offline run or were written before checkpoints existed.
+func FindUnfinishedLogs(dir string) ([]string, error) {
+	var logs []string
+	for _, pattern := range []string{"run-*.wandb", "*/run-*.wandb"} {
+		matches, err := filepath.Glob(filepath.Join(dir, pattern))
+		if err != nil {
+			return nil, err
+		}
+		for _, match := range matches {
+			state, err := LoadCheckpoint(CheckpointPath(match))
+			if err != nil || state.Finished {
+				continue
+			}
+			logs = append(logs, match)
+		}
+	}
+	return logs, nil
+}
+
+// Stored records that a record was written to the store at the given offset.
+func (c *Checkpoint) Stored(record *service.Record, offset int64) {
+	if c == nil {
+		return
+	}
+	c.mu.Lock()
+	defer c.mu.Unlock()
+
+	if !c.runSeen && record.GetRun() != nil {
+		c.state.RunOffset = offset
+		c.runSeen = true
+	}
+
+	if record.GetNum() <= c.state.Num {
+		// the sender got ahead of the writer
+		c.state.Offset = offset
+		return
+	}
+	c.pending = append(c.pending, storedRecord{num: record.GetNum(), offset: offset})
+}
+
+// Handled records that the sender handled the record with the given number.
+func (c *Checkpoint) Handled(num int64) {
+	if c == nil || num <= 0 {
+		return
+	}
+	c.mu.Lock()
+	defer c.mu.Unlock()
+
+	c.handled = max(c.handled, num)
+	c.advance()
+}
+
+// Streamed records that the sender passed the record with the given number
+// to the filestream, so that it is not handled until it is delivered.
+func (c *Checkpoint) Streamed(num int64) {
+	if c == nil || num <= 0 {
+		return
+	}
+	c.mu.Lock()
+	defer c.mu.Unlock()
+
+	c.streamed = append(c.streamed, num)
+}
+
+// Delivered records that the filestream delivered the data of the records
+// up to the given number.
+func (c *Checkpoint) Delivered(num int64) {
+	if c == nil {
+		return
+	}
+	c.mu.Lock()
+	defer c.mu.Unlock()
+
+	delivered := 0
+	for delivered < len(c.streamed) && c.streamed[delivered] <= num {
+		delivered++
+	}
+	c.streamed = c.streamed[delivered:]
+	c.advance()
+}
+
+// advance moves the state up to the last record handled before the first
+// record that is not delivered.
+func (c *Checkpoint) advance() {
+	num := c.handled
+	if len(c.streamed) > 0 {
+		num = min(num, c.streamed[0]-1)
+	}
+	if num <= c.state.Num {
+		return
+	}
+	c.state.Num = num
+
+	handled := 0
+	for _, record := range c.pending {
+		if record.num > num {
+			break
+		}
+		c.state.Offset = record.offset
+		handled++
+	}
+	c.pending = c.pending[handled:]
+}
+
+// Finish marks the run as fully handled by the sender.
+func (c *Checkpoint) Finish() {
+	if c == nil {
+		return
+	}
+	c.mu.Lock()
+	defer c.mu.Unlock()
+
+	c.state.Finished = true
+}
+
+// Save persists the checkpoint.
+//
+// The state is written to a temporary file which then replaces the
+// checkpoint, so that a crash never leaves a partially written checkpoint.
+func (c *Checkpoint) Save() error {
+	if c == nil {
+		return nil
+	}
+	c.mu.Lock()
+	data, err := json.Marshal(c.state)
+	c.mu.Unlock()
+	if err != nil {
+		return err
+	}
+
+	tmpPath := c.path + ".tmp"
+	if err := os.WriteFile(tmpPath, data, 0644); err != nil {
+		return err
+	}
+	return os.Rename(tmpPath, c.path)
+}
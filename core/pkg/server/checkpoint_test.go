@@ -0,0 +1,236 @@
+package server_test
+
+import (
+	"context"
+	"fmt"
+	"io"
+	"net/http"
+	"net/http/httptest"
+	"os"
+	"path/filepath"
+	"strings"
+	"sync"
+	"testing"
+
+	"github.com/segmentio/encoding/json"
+	"github.com/stretchr/testify/assert"
+	"github.com/wandb/wandb/core/internal/settings"
+	"github.com/wandb/wandb/core/pkg/filestream"
+	"github.com/wandb/wandb/core/pkg/observability"
+	"github.com/wandb/wandb/core/pkg/server"
+	"github.com/wandb/wandb/core/pkg/service"
+	"google.golang.org/protobuf/types/known/wrapperspb"
+)
+
+func TestCheckpointTracksHandledRecords(t *testing.T) {
+	path := filepath.Join(t.TempDir(), "run-test.wandb.checkpoint")
+	checkpoint := server.NewCheckpoint(path)
+
+	run := &service.Record{Num: 1, RecordType: &service.Record_Run{Run: &service.RunRecord{}}}
+	checkpoint.Stored(run, 0)
+	checkpoint.Stored(&service.Record{Num: 2}, 100)
+	checkpoint.Stored(&service.Record{Num: 3}, 200)
+	checkpoint.Handled(2)
+	assert.NoError(t, checkpoint.Save())
+
+	state, err := server.LoadCheckpoint(path)
+	assert.NoError(t, err)
+	assert.Equal(t, server.CheckpointState{Num: 2, Offset: 100, RunOffset: 0}, *state)
+
+	// the sender can handle a record before the writer stores it
+	checkpoint.Handled(4)
+	checkpoint.Stored(&service.Record{Num: 4}, 300)
+	checkpoint.Finish()
+	assert.NoError(t, checkpoint.Save())
+
+	state, err = server.LoadCheckpoint(path)
+	assert.NoError(t, err)
+	assert.Equal(t, server.CheckpointState{Num: 4, Offset: 300, Finished: true}, *state)
+}
+
+func TestCheckpointNil(t *testing.T) {
+	var checkpoint *server.Checkpoint
+
+	checkpoint.Stored(&service.Record{Num: 1}, 0)
+	checkpoint.Handled(1)
+	checkpoint.Finish()
+	assert.NoError(t, checkpoint.Save())
+}
+
+func TestFindUnfinishedLogs(t *testing.T) {
+	dir := t.TempDir()
+
+	writeLog := func(name string, state *server.CheckpointState) string {
+		path := filepath.Join(dir, name)
+		assert.NoError(t, os.MkdirAll(filepath.Dir(path), 0755))
+		assert.NoError(t, os.WriteFile(path, []byte{}, 0644))
+		if state != nil {
+			checkpoint := server.NewCheckpoint(server.CheckpointPath(path))
+			checkpoint.Handled(state.Num)
+			if state.Finished {
+				checkpoint.Finish()
+			}
+			assert.NoError(t, checkpoint.Save())
+		}
+		return path
+	}
+
+	unfinished := writeLog("run-1/run-1.wandb", &server.CheckpointState{Num: 10})
+	writeLog("run-2/run-2.wandb", &server.CheckpointState{Num: 20, Finished: true})
+	writeLog("run-3/run-3.wandb", nil)
+
+	logs, err := server.FindUnfinishedLogs(dir)
+	assert.NoError(t, err)
+	assert.Equal(t, []string{unfinished}, logs)
+}
+
+// resendUnfinishedRun syncs a run whose sender stopped after the first
+// three of its records, to a server that answers filestream requests with
+// the given status.
+//
+// It returns the history lines the server got, the response of the sync
+// and the checkpoint of the run after the sync.
+func resendUnfinishedRun(
+	t *testing.T,
+	fileStreamStatus int,
+) ([]string, *service.ErrorInfo, *server.CheckpointState) {
+	mu := sync.Mutex{}
+	var history []string
+	backend := httptest.NewServer(http.HandlerFunc(func(w http.ResponseWriter, r *http.Request) {
+		body, _ := io.ReadAll(r.Body)
+		switch {
+		case strings.Contains(string(body), `"operationName":"UpsertBucket"`):
+			_, _ = w.Write([]byte(`{"data": ` + validUpsertBucketResponse + `}`))
+		case strings.Contains(string(body), `"operationName":"RunResumeStatus"`):
+			_, _ = w.Write([]byte(`{"data": {"model": null}}`))
+		case strings.Contains(string(body), `"operationName":"CreateRunFiles"`):
+			var request struct {
+				Variables struct {
+					Files []string `json:"files"`
+				} `json:"variables"`
+			}
+			_ = json.Unmarshal(body, &request)
+			var files []string
+			for _, name := range request.Variables.Files {
+				files = append(files, fmt.Sprintf(
+					`{"name": %q, "uploadUrl": %q}`,
+					name, "http://"+r.Host+"/upload/"+name))
+			}
+			_, _ = w.Write([]byte(fmt.Sprintf(
+				`{"data": {"createRunFiles": {"runID": "test", "files": [%s]}}}`,
+				strings.Join(files, ","))))
+		case strings.HasPrefix(r.URL.Path, "/upload/"):
+		case r.URL.Path == "/graphql":
+			w.WriteHeader(http.StatusBadRequest)
+		case fileStreamStatus != http.StatusOK:
+			w.WriteHeader(fileStreamStatus)
+		default:
+			var data filestream.FsTransmitData
+			_ = json.Unmarshal(body, &data)
+			mu.Lock()
+			history = append(history, data.Files[filestream.HistoryFileName].Content...)
+			mu.Unlock()
+			_, _ = w.Write([]byte(`{}`))
+		}
+	}))
+	defer backend.Close()
+
+	// write a run whose sender stopped after the first three records
+	syncFile := filepath.Join(t.TempDir(), "run-test.wandb")
+	store := server.NewStore(context.Background(), syncFile, observability.NewNoOpLogger())
+	assert.NoError(t, store.Open(os.O_WRONLY))
+	checkpoint := server.NewCheckpoint(server.CheckpointPath(syncFile))
+	for i := int64(1); i <= 5; i++ {
+		record := &service.Record{
+			Num: i,
+			RecordType: &service.Record_History{History: &service.HistoryRecord{
+				Item: []*service.HistoryItem{{Key: "_step", ValueJson: fmt.Sprint(i)}},
+			}},
+		}
+		if i == 1 {
+			record.RecordType = &service.Record_Run{Run: &service.RunRecord{
+				RunId:   "test",
+				Entity:  "entity",
+				Project: "project",
+				Config:  &service.ConfigRecord{},
+			}}
+		}
+		assert.NoError(t, store.Write(record))
+		offset, err := store.LastRecordOffset()
+		assert.NoError(t, err)
+		checkpoint.Stored(record, offset)
+	}
+	assert.NoError(t, store.Write(&service.Record{
+		Num:        6,
+		RecordType: &service.Record_Exit{Exit: &service.RunExitRecord{}},
+	}))
+	assert.NoError(t, store.Close())
+	checkpoint.Handled(3)
+	assert.NoError(t, checkpoint.Save())
+
+	batch := server.NewBatchSync(server.BatchSyncParams{
+		Logger: observability.NewNoOpLogger(),
+		Settings: settings.From(&service.Settings{
+			BaseUrl: wrapperspb.String(backend.URL),
+			ApiKey:  wrapperspb.String("test-key"),
+		}),
+		Paths: []string{syncFile},
+	})
+	responses := batch.Run(context.Background())
+
+	state, err := server.LoadCheckpoint(server.CheckpointPath(syncFile))
+	assert.NoError(t, err)
+
+	mu.Lock()
+	defer mu.Unlock()
+	return history, responses[0].GetError(), state
+}
+
+func TestSyncResendsUnfinishedRun(t *testing.T) {
+	history, syncErr, state := resendUnfinishedRun(t, http.StatusOK)
+
+	assert.Nil(t, syncErr)
+	assert.Equal(t, []string{`{"_step":4}`, `{"_step":5}`}, history)
+	assert.True(t, state.Finished)
+}
+
+func TestFailedResendIsNotFinished(t *testing.T) {
+	_, syncErr, state := resendUnfinishedRun(t, http.StatusBadRequest)
+
+	// the run is resent again by the next sync
+	assert.NotNil(t, syncErr)
+	assert.False(t, state.Finished)
+}
+
+func TestCheckpointWaitsForDelivery(t *testing.T) {
+	path := filepath.Join(t.TempDir(), "run-test.wandb.checkpoint")
+	checkpoint := server.NewCheckpoint(path)
+
+	for i := int64(1); i <= 4; i++ {
+		checkpoint.Stored(&service.Record{Num: i}, 100*i)
+	}
+	checkpoint.Handled(1)
+	checkpoint.Streamed(2)
+	checkpoint.Handled(2)
+	checkpoint.Handled(3)
+	checkpoint.Streamed(4)
+	checkpoint.Handled(4)
+	assert.NoError(t, checkpoint.Save())
+
+	// the records after the one that is not delivered are sent again
+	state, err := server.LoadCheckpoint(path)
+	assert.NoError(t, err)
+	assert.Equal(t, server.CheckpointState{Num: 1, Offset: 100}, *state)
+
+	checkpoint.Delivered(3)
+	assert.NoError(t, checkpoint.Save())
+	state, err = server.LoadCheckpoint(path)
+	assert.NoError(t, err)
+	assert.Equal(t, server.CheckpointState{Num: 3, Offset: 300}, *state)
+
+	checkpoint.Delivered(4)
+	assert.NoError(t, checkpoint.Save())
+	state, err = server.LoadCheckpoint(path)
+	assert.NoError(t, err)
+	assert.Equal(t, server.CheckpointState{Num: 4, Offset: 400}, *state)
+}
@@ -0,0 +1,262 @@
+package server
+
+import (
+	"context"
+	"fmt"
+	"os"
+	"slices"
+	"strings"
+
+	"github.com/segmentio/encoding/json"
+	"google.golang.org/protobuf/proto"
+
+	"github.com/wandb/wandb/core/internal/runconfig"
+	"github.com/wandb/wandb/core/pkg/observability"
+	"github.com/wandb/wandb/core/pkg/service"
+)
+
+// CompactStats summarizes the work done by CompactStore.
+type CompactStats struct {
+	// Read is the number of records read from the source log
+	Read int
+
+	// Written is the number of records written to the compacted log
+	Written int
+
+	// Corrupt is the number of records of the source log that could not be read
+	Corrupt int
+}
+
+// compactor folds the summary and config records of a transaction log.
+//
+// Summary and config records only carry changes, so all of them except
+// the last one of each kind are superseded by a snapshot of the state
+// after the last one.
+type compactor struct {
+	logger *observability.CoreLogger
+
+	// config is the config built from all config records
+	config *runconfig.RunConfig
+
+	// configRemoved are the paths removed by any config record
+	configRemoved []runconfig.RunConfigPath
+
+	// lastConfig is the index of the last config record
+	lastConfig int
+
+	// summary is the latest update or removal of every summary path,
+	// keyed by the path joined with NUL bytes so that a key with a dot
+	// doesn't collide with the nested path it looks like
+	summary map[string]*service.SummaryItem
+
+	// summaryRemoved is whether the latest change of a summary key removed it
+	summaryRemoved map[string]bool
+
+	// summaryKeys are the summary keys in the order they first appeared
+	summaryKeys []string
+
+	// lastSummary is the index of the last summary record
+	lastSummary int
+}
+
+func newCompactor(logger *observability.CoreLogger) *compactor {
+	return &compactor{
+		logger:         logger,
+		config:         runconfig.New(),
+		lastConfig:     -1,
+		summary:        make(map[string]*service.SummaryItem),
+		summaryRemoved: make(map[string]bool),
+		lastSummary:    -1,
+	}
+}
+
+// fold accumulates the changes of the i-th record of the log.
+func (c *compactor) fold(i int, record *service.Record) {
+	switch x := record.RecordType.(type) {
+	case *service.Record_Config:
+		c.lastConfig = i
+		c.config.ApplyChangeRecord(x.Config, func(err error) {
+			c.logger.CaptureError("compact: failed to fold config", err)
+		})
+		for _, item := range x.Config.GetRemove() {
+			if len(item.GetNestedKey()) > 0 {
+				c.configRemoved = append(c.configRemoved, item.GetNestedKey())
+			} else {
+				c.configRemoved = append(c.configRemoved, runconfig.RunConfigPath{item.GetKey()})
+			}
+		}
+	case *service.Record_Summary:
+		c.lastSummary = i
+		change := func(item *service.SummaryItem, removed bool) {
+			key := strings.Join(summaryKeyPath(item), "\x00")
+			if _, ok := c.summary[key]; !ok {
+				c.summaryKeys = append(c.summaryKeys, key)
+			}
+			c.summary[key] = item
+			c.summaryRemoved[key] = removed
+		}
+		for _, item := range x.Summary.GetUpdate() {
+			change(item, false)
+		}
+		for _, item := range x.Summary.GetRemove() {
+			change(item, true)
+		}
+	}
+}
+
+// configSnapshot returns a config record that sets every key of the
+// folded config and removes the keys that ended up removed.
+func (c *compactor) configSnapshot() (*service.ConfigRecord, error) {
+	tree := c.config.Tree()
+	snapshot := &service.ConfigRecord{}
+
+	keys := make([]string, 0, len(tree))
+	for key := range tree {
+		keys = append(keys, key)
+	}
+	slices.Sort(keys)
+	for _, key := range keys {
+		valueJson, err := json.Marshal(tree[key])
+		if err != nil {
+			return nil, err
+		}
+		snapshot.Update = append(snapshot.Update, &service.ConfigItem{
+			Key:       key,
+			ValueJson: string(valueJson),
+		})
+	}
+
+	seen := make(map[string]bool)
+	for _, path := range c.configRemoved {
+		id := strings.Join(path, "\x00")
+		if seen[id] || hasPath(tree, path) {
+			continue
+		}
+		seen[id] = true
+		if len(path) == 1 {
+			snapshot.Remove = append(snapshot.Remove, &service.ConfigItem{Key: path[0]})
+		} else {
+			snapshot.Remove = append(snapshot.Remove, &service.ConfigItem{NestedKey: path})
+		}
+	}
+	return snapshot, nil
+}
+
+// hasPath returns whether the config tree has a value at the path.
+func hasPath(tree runconfig.RunConfigDict, path runconfig.RunConfigPath) bool {
+	for i, key := range path {
+		value, ok := tree[key]
+		if !ok {
+			return false
+		}
+		if i == len(path)-1 {
+			return true
+		}
+		subtree, ok := value.(map[string]interface{})
+		if !ok {
+			return false
+		}
+		tree = subtree
+	}
+	return false
+}
+
+// summarySnapshot returns a summary record with the latest change of
+// every summary key.
+func (c *compactor) summarySnapshot() *service.SummaryRecord {
+	snapshot := &service.SummaryRecord{}
+	for _, key := range c.summaryKeys {
+		if c.summaryRemoved[key] {
+			snapshot.Remove = append(snapshot.Remove, c.summary[key])
+		} else {
+			snapshot.Update = append(snapshot.Update, c.summary[key])
+		}
+	}
+	return snapshot
+}
+
+// CompactStore writes a copy of the transaction log src to dst in which
+// superseded summary and config records are folded into snapshots.
+//
+// Each snapshot takes the place of the last record of its kind, so that
+// replaying the compacted log ends in the same state as replaying src.
+// The compacted log has different record offsets, so it must not be
+// compacted while a checkpoint refers to it.
+func CompactStore(
+	ctx context.Context,
+	logger *observability.CoreLogger,
+	src string,
+	dst string,
+	opts ...StoreOption,
+) (CompactStats, error) {
+	stats := CompactStats{}
+
+	in := NewStore(ctx, src, logger)
+	if err := in.Open(os.O_RDONLY); err != nil {
+		return stats, err
+	}
+	defer in.Close()
+
+	// first pass: fold all changes
+	compactor := newCompactor(logger)
+	i := 0
+	replayStats, err := in.Replay(0, 0, func(record *service.Record) {
+		compactor.fold(i, record)
+		i++
+	})
+	if err != nil {
+		return stats, err
+	}
+	stats.Read = replayStats.Read
+	stats.Corrupt = replayStats.Corrupt
+
+	configSnapshot, err := compactor.configSnapshot()
+	if err != nil {
+		return stats, fmt.Errorf("compact: failed to build config snapshot: %v", err)
+	}
+	summarySnapshot := compactor.summarySnapshot()
+
+	out := NewStore(ctx, dst, logger, opts...)
+	if err := out.Open(os.O_WRONLY); err != nil {
+		return stats, err
+	}
+
+	// second pass: copy the records, replacing the folded ones
+	i = 0
+	var writeErr error
+	_, err = in.Replay(0, 0, func(record *service.Record) {
+		defer func() { i++ }()
+		if writeErr != nil {
+			return
+		}
+
+		switch x := record.RecordType.(type) {
+		case *service.Record_Config:
+			if i != compactor.lastConfig {
+				return
+			}
+			record = proto.Clone(record).(*service.Record)
+			record.RecordType = &service.Record_Config{Config: configSnapshot}
+			configSnapshot.XInfo = x.Config.GetXInfo()
+		case *service.Record_Summary:
+			if i != compactor.lastSummary {
+				return
+			}
+			record = proto.Clone(record).(*service.Record)
+			record.RecordType = &service.Record_Summary{Summary: summarySnapshot}
+			summarySnapshot.XInfo = x.Summary.GetXInfo()
+		}
+
+		if writeErr = out.Write(record); writeErr == nil {
+			stats.Written++
+		}
+	})
+
+	if closeErr := out.Close(); closeErr != nil && writeErr == nil {
+		writeErr = closeErr
+	}
+	if err != nil {
+		return stats, err
+	}
+	return stats, writeErr
+}
@@ -0,0 +1,256 @@
+package server
+
+import (
+	"fmt"
+	"strings"
+	"time"
+	"unicode/utf8"
+
+	"github.com/wandb/wandb/core/internal/terminal"
+	fs "github.com/wandb/wandb/core/pkg/filestream"
+	"github.com/wandb/wandb/core/pkg/observability"
+	"github.com/wandb/wandb/core/pkg/service"
+)
+
+const (
+	// outputTerminalHeight is the number of lines at the end of each of
+	// stdout and stderr that cursor movements can rewrite
+	outputTerminalHeight = 100
+
+	// maxPendingOutputLines is the number of lines that may wait to be
+	// written to the output file until earlier lines stop changing
+	maxPendingOutputLines = 1000
+
+	// outputTimestampLength is the length of the timestamp that prefixes
+	// every output line, with the space after it
+	outputTimestampLength = len("2006-01-02T15:04:05.000000 ")
+)
+
+// outputTerminalWidth is the column at which output lines wrap, so that
+// they fit the backend's limit with their prefix.
+var outputTerminalWidth = (maxOutputLineLength - len("ERROR ") - outputTimestampLength) / utf8.UTFMax
+
+// outputConsole turns a run's console output into the lines of its output
+// file.
+//
+// Output is written to an emulated terminal for each of stdout and stderr,
+// so that lines rewritten with carriage returns or cursor movements, such
+// as progress bars, are sent as the lines they end up as. Lines are
+// numbered in the order they are first seen; the filestream gets each line
+// when it settles and again whenever it changes. The output file gets each
+// line once it can no longer change.
+type outputConsole struct {
+	logger *observability.CoreLogger
+
+	// fileStream gets the lines of output, if not nil
+	fileStream fs.FileStream
+
+	// outputFile is the path of the output file
+	outputFile string
+
+	streams map[service.OutputRawRecord_OutputType]*consoleStream
+
+	// nextLine is the number of the next new line
+	nextLine int
+
+	// pending is the lines not yet written to the output file, by number;
+	// written is the number of lines written to it
+	pending map[int]*pendingOutputLine
+	written int
+}
+
+// consoleStream is the terminal of one of stdout and stderr.
+type consoleStream struct {
+	terminal *terminal.Terminal
+
+	// prefix goes before the timestamp of each line
+	prefix string
+
+	// lastWrite is when output was last written, which is the time
+	// that the lines changed since are stamped with
+	lastWrite time.Time
+
+	// lines maps the lines of the terminal that can still change to their
+	// numbers in the output
+	lines map[int]int
+}
+
+// pendingOutputLine is a line to write to the output file.
+type pendingOutputLine struct {
+	content string
+
+	// final is whether the line can no longer change
+	final bool
+}
+
+func newOutputConsole(
+	logger *observability.CoreLogger,
+	fileStream fs.FileStream,
+	outputFile string,
+) *outputConsole {
+	return &outputConsole{
+		logger:     logger,
+		fileStream: fileStream,
+		outputFile: outputFile,
+		streams:    make(map[service.OutputRawRecord_OutputType]*consoleStream),
+		pending:    make(map[int]*pendingOutputLine),
+	}
+}
+
+// write writes output to stdout or stderr at the given time.
+func (c *outputConsole) write(
+	outputType service.OutputRawRecord_OutputType,
+	output string,
+	at time.Time,
+) error {
+	stream, ok := c.streams[outputType]
+	if !ok {
+		var prefix string
+		switch outputType {
+		case service.OutputRawRecord_STDOUT:
+		case service.OutputRawRecord_STDERR:
+			prefix = "ERROR "
+		default:
+			return fmt.Errorf("unexpected output type %v", outputType)
+		}
+		stream = &consoleStream{
+			terminal: terminal.New(terminal.Params{
+				Width:  outputTerminalWidth,
+				Height: outputTerminalHeight,
+			}),
+			prefix: prefix,
+			lines:  make(map[int]int),
+		}
+		c.streams[outputType] = stream
+	}
+
+	stream.terminal.Write(output)
+	stream.lastWrite = at
+	return nil
+}
+
+// update sends the lines that settled since the last update.
+func (c *outputConsole) update() {
+	c.read(false)
+}
+
+// refresh sends the lines that changed since the last update, including
+// the lines still being written, such as a progress bar.
+func (c *outputConsole) refresh() {
+	c.read(true)
+}
+
+// flush sends all changed lines and writes all lines to the output file.
+func (c *outputConsole) flush() {
+	c.read(true)
+	c.writeFile(true)
+}
+
+func (c *outputConsole) read(all bool) {
+	var lines []fs.OutputLine
+	for _, outputType := range []service.OutputRawRecord_OutputType{
+		service.OutputRawRecord_STDOUT,
+		service.OutputRawRecord_STDERR,
+	} {
+		stream, ok := c.streams[outputType]
+		if !ok {
+			continue
+		}
+
+		var changed []terminal.Line
+		if all {
+			changed = stream.terminal.Flush()
+		} else {
+			changed = stream.terminal.Read()
+		}
+		// generate compatible timestamp to python iso-format (microseconds without Z)
+		timestamp := strings.TrimSuffix(stream.lastWrite.UTC().Format(RFC3339Micro), "Z")
+		for _, line := range changed {
+			lines = append(lines, c.outputLine(stream, line, timestamp))
+		}
+
+		// lines that scrolled off the terminal can no longer change
+		for terminalNum, num := range stream.lines {
+			if terminalNum >= stream.terminal.FirstLine() {
+				continue
+			}
+			if pending, ok := c.pending[num]; ok {
+				pending.final = true
+			}
+			delete(stream.lines, terminalNum)
+		}
+	}
+
+	c.writeFile(false)
+	if c.fileStream != nil && len(lines) > 0 {
+		c.fileStream.StreamOutput(lines)
+	}
+}
+
+// outputLine returns the line to send for a changed line of a terminal.
+func (c *outputConsole) outputLine(
+	stream *consoleStream,
+	line terminal.Line,
+	timestamp string,
+) fs.OutputLine {
+	num, ok := stream.lines[line.Num]
+	if !ok {
+		num = c.nextLine
+		c.nextLine++
+		stream.lines[line.Num] = num
+	}
+
+	// lines already in the output file stay as they were
+	if num >= c.written {
+		c.pending[num] = &pendingOutputLine{content: line.Content}
+	}
+
+	return fs.OutputLine{
+		Num: num,
+		Content: truncateOutputLine(
+			stream.prefix+timestamp+" "+line.Content,
+			maxOutputLineLength,
+		),
+	}
+}
+
+// writeFile appends the lines that can no longer change to the output file,
+// in order, or all lines.
+//
+// A line that is still changing holds back the lines after it, until too
+// many lines are waiting.
+func (c *outputConsole) writeFile(all bool) {
+	var lines []string
+	for {
+		line, ok := c.pending[c.written]
+		if !ok || !line.final && !all && len(c.pending) <= maxPendingOutputLines {
+			break
+		}
+		lines = append(lines, line.content)
+		delete(c.pending, c.written)
+		c.written++
+	}
+	if len(lines) == 0 {
+		return
+	}
+
+	if err := writeOutputToFile(c.outputFile, strings.Join(lines, "\n")); err != nil {
+		c.logger.Error("sender: sendOutput: failed to write to output file", "error", err)
+	}
+}
+
+// truncateOutputLine cuts a line to at most maxLength bytes, without
+// splitting UTF-8 characters.
+//
+// Lines are wrapped to fit the backend's limit, so only lines with many
+// style codes are cut.
+func truncateOutputLine(line string, maxLength int) string {
+	if len(line) <= maxLength {
+		return line
+	}
+	end := maxLength
+	for end > 0 && !utf8.RuneStart(line[end]) {
+		end--
+	}
+	return line[:end]
+}
@@ -8,19 +8,19 @@ import (
 	"path/filepath"
 	"strconv"
 	"strings"
+	"time"
 
-	"github.com/segmentio/encoding/json"
 	"github.com/wandb/wandb/core/pkg/monitor"
 	"github.com/wandb/wandb/core/pkg/utils"
 	"google.golang.org/protobuf/encoding/protojson"
 	"google.golang.org/protobuf/proto"
 
-	"github.com/wandb/wandb/core/internal/corelib"
 	"github.com/wandb/wandb/core/internal/filetransfer"
 	"github.com/wandb/wandb/core/internal/mailbox"
 	"github.com/wandb/wandb/core/internal/runfiles"
 	"github.com/wandb/wandb/core/internal/sampler"
 	"github.com/wandb/wandb/core/internal/version"
+	"github.com/wandb/wandb/core/pkg/filestream"
 	"github.com/wandb/wandb/core/pkg/observability"
 	"github.com/wandb/wandb/core/pkg/service"
 )
@@ -34,6 +34,10 @@ const (
 	ConfigFileName            = "config.yaml"
 	summaryDebouncerRateLimit = 1 / 30.0 // todo: audit rate limit
 	summaryDebouncerBurstSize = 1        // todo: audit burst size
+
+	// sampledHistorySize is the number of values of each history key
+	// sampled for the sparklines shown at the end of a run
+	sampledHistorySize = 48
 )
 
 type HandlerOption func(*Handler)
@@ -98,6 +102,12 @@ func WithHandlerMailbox(mailbox *mailbox.Mailbox) HandlerOption {
 	}
 }
 
+func WithHandlerHistoryMerge(merge filestream.HistoryMerge) HandlerOption {
+	return func(h *Handler) {
+		h.historyMerge = merge
+	}
+}
+
 // Handler is the handler for a stream it handles the incoming messages, processes them
 // and passes them to the writer
 type Handler struct {
@@ -134,7 +144,7 @@ type Handler struct {
 	// the sparkline in the terminal
 	//
 	// TODO: currently only values that can be cast to float32 are supported
-	samplers map[string]*sampler.ReservoirSampler[float32]
+	samplers map[string]*sampler.NumberSampler
 
 	// metricHandler is the metric handler for the stream
 	metricHandler *MetricHandler
@@ -157,6 +167,10 @@ type Handler struct {
 	internalPrinter *observability.Printer[string]
 
 	mailbox *mailbox.Mailbox
+
+	// historyMerge is how the history of the writers of a shared run is
+	// merged, which decides whether this writer numbers its rows
+	historyMerge filestream.HistoryMerge
 }
 
 // NewHandler creates a new handler
@@ -188,6 +202,9 @@ func (h *Handler) Do(inChan <-chan *service.Record) {
 }
 
 func (h *Handler) Close() {
+	if h.summaryHandler != nil {
+		h.summaryHandler.Close()
+	}
 	close(h.outChan)
 	close(h.fwdChan)
 	h.logger.Debug("handler: Close: closed", "stream_id", h.settings.RunId)
@@ -442,6 +459,10 @@ func (h *Handler) handleMetric(record *service.Record, metric *service.MetricRec
 			h.logger.CaptureError("error adding metric to map", err)
 			return
 		}
+		defined := h.metricHandler.definedMetrics[metric.GetName()]
+		if _, err := h.metricHandler.stepChain(defined); err != nil {
+			h.logger.CaptureWarn("handler: step metric not synced", "error", err)
+		}
 		h.handleStepMetric(metric.GetStepMetric())
 		h.fwdRecord(record)
 	default:
@@ -898,9 +919,11 @@ func (h *Handler) handleExit(record *service.Record, exit *service.RunExitRecord
 
 	// update summary with runtime
 	if !h.settings.GetXSync().GetValue() {
-		summaryRecord := corelib.ConsolidateSummaryItems(h.summaryHandler.consolidatedSummary, []*service.SummaryItem{
-			{
-				Key: "_wandb", ValueJson: fmt.Sprintf(`{"runtime": %d}`, runtime),
+		summaryRecord := h.summaryHandler.updateSummary(&service.SummaryRecord{
+			Update: []*service.SummaryItem{
+				{
+					Key: "_wandb", ValueJson: fmt.Sprintf(`{"runtime": %d}`, runtime),
+				},
 			},
 		})
 		h.summaryHandler.updateSummaryDelta(summaryRecord)
@@ -928,13 +951,9 @@ func (h *Handler) handleFiles(record *service.Record) {
 func (h *Handler) handleRequestGetSummary(record *service.Record) {
 	response := &service.Response{}
 
-	var items []*service.SummaryItem
-	for key, element := range h.summaryHandler.consolidatedSummary {
-		items = append(items, &service.SummaryItem{Key: key, ValueJson: element})
-	}
 	response.ResponseType = &service.Response_GetSummaryResponse{
 		GetSummaryResponse: &service.GetSummaryResponse{
-			Item: items,
+			Item: h.summaryHandler.Items(),
 		},
 	}
 	h.respond(record, response)
@@ -1013,14 +1032,8 @@ func (h *Handler) writeAndSendSummaryFile() {
 	// write summary to file
 	summaryFile := filepath.Join(h.settings.GetFilesDir().GetValue(), SummaryFileName)
 
-	jsonBytes, err := json.MarshalIndent(h.summaryHandler.consolidatedSummary, "", "  ")
-	if err != nil {
-		h.logger.Error("handler: writeAndSendSummaryFile: error marshalling summary", "error", err)
-		return
-	}
-
-	if err := os.WriteFile(summaryFile, []byte(jsonBytes), 0644); err != nil {
-		h.logger.Error("handler: writeAndSendSummaryFile: failed to write config file", "error", err)
+	if err := h.summaryHandler.writeFile(summaryFile); err != nil {
+		h.logger.Error("handler: writeAndSendSummaryFile: failed to write summary file", "error", err)
 	}
 
 	// send summary file
@@ -1041,6 +1054,12 @@ func (h *Handler) fwdSummary() {
 		})
 	}
 
+	for key := range h.summaryHandler.summaryRemoved {
+		summaryRecord.Remove = append(summaryRecord.Remove, &service.SummaryItem{
+			Key: key,
+		})
+	}
+
 	record := &service.Record{
 		RecordType: &service.Record_Summary{
 			Summary: summaryRecord,
@@ -1049,6 +1068,7 @@ func (h *Handler) fwdSummary() {
 	h.fwdRecord(record)
 	// reset delta summary
 	clear(h.summaryHandler.summaryDelta)
+	clear(h.summaryHandler.summaryRemoved)
 }
 
 func (h *Handler) handleSummary(_ *service.Record, summary *service.SummaryRecord) {
@@ -1064,7 +1084,7 @@ func (h *Handler) handleSummary(_ *service.Record, summary *service.SummaryRecor
 		Key: "_wandb", ValueJson: fmt.Sprintf(`{"runtime": %d}`, runtime),
 	})
 
-	summaryRecord := corelib.ConsolidateSummaryItems(h.summaryHandler.consolidatedSummary, summary.Update)
+	summaryRecord := h.summaryHandler.updateSummary(summary)
 	h.summaryHandler.updateSummaryDelta(summaryRecord)
 }
 
@@ -1102,14 +1122,31 @@ func (h *Handler) handleRequestNetworkStatus(record *service.Record) {
 // determined by the action in the partial history request and the step number.
 // Once a full history record is received, it is forwarded to the writer.
 func (h *Handler) handleRequestPartialHistory(_ *service.Record, request *service.PartialHistoryRequest) {
-	if h.settings.GetXShared().GetValue() {
+	if h.isServerStepped() {
 		h.handlePartialHistoryAsync(request)
 	} else {
 		h.handlePartialHistorySync(request)
 	}
 }
 
-// The main entry point for partial history records in the shared mode.
+// isServerStepped returns whether the server numbers the history rows,
+// which is the case for shared runs unless the writers merge rows by step.
+func (h *Handler) isServerStepped() bool {
+	return h.settings.GetXShared().GetValue() &&
+		h.historyMerge != filestream.HistoryMergeStep
+}
+
+func hasHistoryItem(history *service.HistoryRecord, key string) bool {
+	for _, item := range history.GetItem() {
+		if item.GetKey() == key {
+			return true
+		}
+	}
+	return false
+}
+
+// The main entry point for partial history records when the server numbers
+// the rows, as in the shared mode.
 //
 // This collects partial history records until a full history record is
 // received. This is the asynchronous version of handlePartialHistory.
@@ -1137,7 +1174,8 @@ func (h *Handler) handlePartialHistoryAsync(request *service.PartialHistoryReque
 	}
 }
 
-// The main entry point for partial history records in the non-shared mode.
+// The main entry point for partial history records when the client numbers
+// the rows, as in the non-shared mode.
 //
 // This collects partial history records until a full history record is
 // received. This is the synchronous version of handlePartialHistory.
@@ -1254,38 +1292,44 @@ func (h *Handler) matchHistoryItemMetric(item *service.HistoryItem) *service.Met
 	return metric
 }
 
-// sync history item with step metric if needed
+// imputeStepMetrics returns the step values to add for a history item
 //
 // This function checks if a history item matches a defined metric or a glob
-// metric. If the step metric is not part of the history record, and it needs to
-// be synced, the function imputes the step metric and returns it.
-func (h *Handler) imputeStepMetric(item *service.HistoryItem) *service.HistoryItem {
+// metric. For each step metric that the item's metric syncs, directly or
+// through other step metrics, and that is not part of the history record,
+// the function imputes the step value from the summary.
+func (h *Handler) imputeStepMetrics(item *service.HistoryItem) []*service.HistoryItem {
 
 	// check if history item matches a defined metric or a glob metric
 	metric := h.matchHistoryItemMetric(item)
-
-	key := metric.GetStepMetric()
-	// check if step metric is defined and if it needs to be synced
-	if !(metric.GetOptions().GetStepSync() && key != "") {
+	if metric == nil {
 		return nil
 	}
 
-	// check if step metric is already in history
-	if _, ok := h.activeHistory.GetItem(key); ok {
-		return nil
-	}
+	// a cycle is reported when the metric is defined; the chain stops at it
+	chain, _ := h.metricHandler.stepChain(metric)
 
-	// we use the summary value of the metric as the algorithm for imputing the step metric
-	if value, ok := h.summaryHandler.consolidatedSummary[key]; ok {
+	var steps []*service.HistoryItem
+	for _, key := range chain {
+		// check if step metric is already in history
+		if _, ok := h.activeHistory.GetItem(key); ok {
+			continue
+		}
+
+		// we use the summary value of the metric as the algorithm for imputing the step metric
+		value, ok := h.summaryHandler.latestValue(key)
+		if !ok {
+			continue
+		}
 		// TODO: add nested key support
 		hi := &service.HistoryItem{
 			Key:       key,
 			ValueJson: value,
 		}
 		h.activeHistory.UpdateValues([]*service.HistoryItem{hi})
-		return hi
+		steps = append(steps, hi)
 	}
-	return nil
+	return steps
 }
 
 // samples history items and updates the history record with the sampled values
@@ -1299,10 +1343,21 @@ func (h *Handler) handleRequestSampledHistory(record *service.Record) {
 	if h.samplers != nil {
 		var items []*service.SampledHistoryItem
 		for key, sampler := range h.samplers {
-			values := sampler.Sample()
 			item := &service.SampledHistoryItem{
-				Key:         key,
-				ValuesFloat: values,
+				Key:  key,
+				Min:  sampler.Min(),
+				Max:  sampler.Max(),
+				Last: sampler.Last(),
+			}
+			if sampler.IsInt() {
+				item.ValuesInt = sampler.SampleInts()
+			} else {
+				values := sampler.Sample()
+				item.ValuesDouble = values
+				item.ValuesFloat = make([]float32, len(values))
+				for i, value := range values {
+					item.ValuesFloat[i] = float32(value)
+				}
 			}
 			items = append(items, item)
 		}
@@ -1322,23 +1377,29 @@ func (h *Handler) handleRequestSampledHistory(record *service.Record) {
 func (h *Handler) sampleHistory(history *service.HistoryRecord) {
 	// initialize the samplers map if it doesn't exist
 	if h.samplers == nil {
-		h.samplers = make(map[string]*sampler.ReservoirSampler[float32])
+		h.samplers = make(map[string]*sampler.NumberSampler)
 	}
 
 	for _, item := range history.GetItem() {
-		var value float32
-		if err := json.Unmarshal([]byte(item.ValueJson), &value); err != nil {
-			// ignore items that cannot be parsed as float32
+		// values are JSON numbers, or NaN and infinities as Python writes
+		// them; other values are not sampled
+		intValue, intErr := strconv.ParseInt(item.ValueJson, 10, 64)
+		floatValue, floatErr := strconv.ParseFloat(item.ValueJson, 64)
+		if intErr != nil && floatErr != nil {
 			continue
 		}
 
 		// create a new sampler if it doesn't exist
 		if _, ok := h.samplers[item.Key]; !ok {
-			h.samplers[item.Key] = sampler.NewReservoirSampler[float32](48, 0.0005)
+			h.samplers[item.Key] = sampler.NewNumberSampler(sampledHistorySize)
 		}
 
 		// add the new value to the sampler
-		h.samplers[item.Key].Add(value)
+		if intErr == nil {
+			h.samplers[item.Key].AddInt(intValue)
+		} else {
+			h.samplers[item.Key].AddFloat(floatValue)
+		}
 	}
 }
 
@@ -1371,25 +1432,33 @@ func (h *Handler) flushHistory(history *service.HistoryRecord) {
 	history.Item = append(history.Item,
 		&service.HistoryItem{Key: "_runtime", ValueJson: fmt.Sprintf("%f", runTime)},
 	)
-	if !h.settings.GetXShared().GetValue() {
+	if !h.isServerStepped() {
 		history.Item = append(history.Item,
 			&service.HistoryItem{Key: "_step", ValueJson: fmt.Sprintf("%d", history.GetStep().GetNum())},
 		)
 	}
+	if h.settings.GetXShared().GetValue() &&
+		h.historyMerge == filestream.HistoryMergeTimestamp &&
+		!hasHistoryItem(history, "_timestamp") {
+		// rows of shared runs are put in order by their timestamp
+		timestamp := float64(time.Now().UnixMicro()) / 1e6
+		history.Item = append(history.Item,
+			&service.HistoryItem{Key: "_timestamp", ValueJson: strconv.FormatFloat(timestamp, 'f', -1, 64)},
+		)
+	}
 
 	// handles all history items. It is responsible for matching current history
 	// items with defined metrics, and creating new metrics if needed. It also handles step metric in case
 	// it needs to be synced, but not part of the history record.
 	// This means that there are metrics defined for this run
 	if h.metricHandler != nil {
+		// the imputed step values are added once all items are matched, as
+		// they are not items of the row to match with metrics
+		var steps []*service.HistoryItem
 		for _, item := range history.GetItem() {
-			step := h.imputeStepMetric(item)
-			// TODO: fix this, we update history while we are iterating over it
-			// TODO: handle nested step metrics (e.g. step defined by another step)
-			if step != nil {
-				history.Item = append(history.Item, step)
-			}
+			steps = append(steps, h.imputeStepMetrics(item)...)
 		}
+		history.Item = append(history.Item, steps...)
 	}
 
 	h.sampleHistory(history)
@@ -1404,7 +1473,11 @@ func (h *Handler) flushHistory(history *service.HistoryRecord) {
 	if h.summaryHandler == nil {
 		return
 	}
-	summary := corelib.ConsolidateSummaryItems(h.summaryHandler.consolidatedSummary, history.GetItem())
+	var metrics map[string]*service.MetricRecord
+	if h.metricHandler != nil {
+		metrics = h.metricHandler.definedMetrics
+	}
+	summary := h.summaryHandler.updateFromHistory(history.GetItem(), metrics)
 	h.summaryHandler.updateSummaryDelta(summary)
 }
 
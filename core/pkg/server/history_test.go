@@ -1,8 +1,17 @@
 package server_test
 
 import (
+	"context"
+	"fmt"
+	"math"
 	"testing"
 
+	"github.com/stretchr/testify/assert"
+	"google.golang.org/protobuf/types/known/wrapperspb"
+
+	"github.com/wandb/wandb/core/pkg/filestream"
+	"github.com/wandb/wandb/core/pkg/observability"
+	"github.com/wandb/wandb/core/pkg/server"
 	"github.com/wandb/wandb/core/pkg/service"
 )
 
@@ -818,3 +827,108 @@ func TestHandleHistory(t *testing.T) {
 	}
 
 }
+
+func TestSharedHistoryMerge(t *testing.T) {
+	testCases := []struct {
+		merge         filestream.HistoryMerge
+		hasStep       bool
+		hasTimestamp  bool
+		expectedSteps []int64
+	}{
+		{filestream.HistoryMergeServerStep, false, false, []int64{0, 0}},
+		{filestream.HistoryMergeStep, true, false, []int64{3, 4}},
+		{filestream.HistoryMergeTimestamp, false, true, []int64{0, 0}},
+	}
+	for _, tc := range testCases {
+		t.Run(string(tc.merge), func(t *testing.T) {
+			inChan, _ := makeInboundChannels()
+			fwdChan, outChan := makeOutboundChannels()
+			h := server.NewHandler(context.Background(),
+				observability.NewNoOpLogger(),
+				server.WithHandlerSettings(&service.Settings{XShared: wrapperspb.Bool(true)}),
+				server.WithHandlerFwdChannel(fwdChan),
+				server.WithHandlerOutChannel(outChan),
+				server.WithHandlerHistoryMerge(tc.merge),
+			)
+			go h.Do(inChan)
+
+			inChan <- makePartialHistoryRecord(data{
+				items: map[string]string{"loss": "1"},
+				step:  3,
+				flush: true,
+			})
+			inChan <- makePartialHistoryRecord(data{
+				items: map[string]string{"loss": "2"},
+				step:  4,
+				flush: true,
+			})
+
+			for _, step := range tc.expectedSteps {
+				items := map[string]string{}
+				for _, item := range (<-fwdChan).GetHistory().GetItem() {
+					items[item.Key] = item.ValueJson
+				}
+				_, hasStep := items["_step"]
+				_, hasTimestamp := items["_timestamp"]
+				assert.Equal(t, tc.hasStep, hasStep)
+				assert.Equal(t, tc.hasTimestamp, hasTimestamp)
+				if tc.hasStep {
+					assert.Equal(t, fmt.Sprintf("%d", step), items["_step"])
+				}
+			}
+		})
+	}
+}
+
+func TestSampledHistory(t *testing.T) {
+	inChan, loopbackChan := makeInboundChannels()
+	fwdChan, outChan := makeOutboundChannels()
+	makeHandler(inChan, loopbackChan, fwdChan, outChan, false)
+
+	inChan <- makeHistoryRecord(data{items: map[string]string{
+		"acc":   "0.123456789012345",
+		"epoch": "3",
+		"loss":  "NaN",
+		"name":  `"resnet"`,
+		"seed":  "9007199254740993",
+	}, step: 0})
+	inChan <- makeHistoryRecord(data{items: map[string]string{
+		"acc":   "Infinity",
+		"epoch": "4",
+		"loss":  "1.5",
+	}, step: 1})
+	inChan <- &service.Record{RecordType: &service.Record_Request{
+		Request: &service.Request{RequestType: &service.Request_SampledHistory{
+			SampledHistory: &service.SampledHistoryRequest{},
+		}},
+	}}
+
+	var response *service.SampledHistoryResponse
+	for response == nil {
+		response = (<-outChan).GetResponse().GetSampledHistoryResponse()
+	}
+	items := map[string]*service.SampledHistoryItem{}
+	for _, item := range response.Item {
+		items[item.Key] = item
+	}
+
+	assert.NotContains(t, items, "name")
+
+	assert.Equal(t, []int64{3, 4}, items["epoch"].ValuesInt)
+	assert.Empty(t, items["epoch"].ValuesDouble)
+	assert.Equal(t, 4.0, items["epoch"].Last)
+	// ints beyond 2^53 are exact
+	assert.Equal(t, []int64{9007199254740993}, items["seed"].ValuesInt)
+
+	acc := items["acc"]
+	assert.Equal(t, []float64{0.123456789012345, math.Inf(1)}, acc.ValuesDouble)
+	assert.Equal(t, []float32{0.123456789012345, float32(math.Inf(1))}, acc.ValuesFloat)
+	assert.Equal(t, 0.123456789012345, acc.Min)
+	assert.Equal(t, math.Inf(1), acc.Max)
+
+	loss := items["loss"]
+	assert.Len(t, loss.ValuesDouble, 2)
+	assert.True(t, math.IsNaN(loss.ValuesDouble[0]))
+	assert.Equal(t, 1.5, loss.Min)
+	assert.Equal(t, 1.5, loss.Max)
+}
@@ -2,7 +2,9 @@ package server
 
 import (
 	"errors"
+	"fmt"
 	"path/filepath"
+	"strings"
 
 	"github.com/wandb/wandb/core/internal/corelib"
 	"github.com/wandb/wandb/core/pkg/service"
@@ -68,6 +70,39 @@ func (mh *MetricHandler) createMatchingGlobMetric(key string) *service.MetricRec
 	return nil
 }
 
+// errStepMetricCycle is returned for step metrics that sync each other.
+var errStepMetricCycle = errors.New("step metrics form a cycle")
+
+// stepChain returns the step metrics whose values are synced with those of a
+// metric, nearest first.
+//
+// A metric with step sync syncs its step metric, which syncs its own step
+// metric if it has step sync too, and so on. For example, with "batch"
+// stepped by "epoch" and "epoch" stepped by "global_step", the chain of
+// "batch" is "epoch", "global_step". A chain that comes back to a metric
+// already in it stops there, and errStepMetricCycle is returned with it.
+func (mh *MetricHandler) stepChain(metric *service.MetricRecord) ([]string, error) {
+	name := metric.GetName()
+	var chain []string
+	seen := map[string]bool{name: true}
+	for metric.GetOptions().GetStepSync() && metric.GetStepMetric() != "" {
+		key := metric.GetStepMetric()
+		if seen[key] {
+			path := append(append([]string{name}, chain...), key)
+			return chain, fmt.Errorf("%w: %s", errStepMetricCycle, strings.Join(path, " -> "))
+		}
+		seen[key] = true
+		chain = append(chain, key)
+
+		next, ok := mh.definedMetrics[key]
+		if !ok {
+			break
+		}
+		metric = next
+	}
+	return chain, nil
+}
+
 type MetricSender struct {
 	definedMetrics map[string]*service.MetricRecord
 	metricIndex    map[string]int32
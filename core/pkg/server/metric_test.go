@@ -0,0 +1,221 @@
+package server_test
+
+import (
+	"context"
+	"testing"
+
+	"github.com/stretchr/testify/assert"
+
+	"github.com/wandb/wandb/core/internal/summarystore"
+	"github.com/wandb/wandb/core/pkg/observability"
+	"github.com/wandb/wandb/core/pkg/server"
+	"github.com/wandb/wandb/core/pkg/service"
+)
+
+// makeMetricHandler returns the input, forwarding and output channels of a
+// handler that tracks metrics and the summary.
+func makeMetricHandler() (
+	chan *service.Record,
+	chan *service.Record,
+	chan *service.Result,
+) {
+	inChan, _ := makeInboundChannels()
+	fwdChan, outChan := makeOutboundChannels()
+	h := server.NewHandler(context.Background(),
+		observability.NewNoOpLogger(),
+		server.WithHandlerSettings(&service.Settings{}),
+		server.WithHandlerFwdChannel(fwdChan),
+		server.WithHandlerOutChannel(outChan),
+		server.WithHandlerSummaryHandler(server.NewSummaryHandler(
+			observability.NewNoOpLogger(),
+			summarystore.Params{},
+		)),
+		server.WithHandlerMetricHandler(server.NewMetricHandler()),
+	)
+	go h.Do(inChan)
+	return inChan, fwdChan, outChan
+}
+
+func makeMetricRecord(metric *service.MetricRecord) *service.Record {
+	return &service.Record{RecordType: &service.Record_Metric{Metric: metric}}
+}
+
+// stepMetric defines a metric whose values sync a step metric.
+func stepMetric(name, step string) *service.MetricRecord {
+	return &service.MetricRecord{
+		Name:       name,
+		StepMetric: step,
+		Options:    &service.MetricOptions{StepSync: true},
+	}
+}
+
+// nextHistory returns the items of the next history row forwarded,
+// skipping other records.
+func nextHistory(fwdChan chan *service.Record) map[string]string {
+	for {
+		history := (<-fwdChan).GetHistory()
+		if history == nil {
+			continue
+		}
+		items := map[string]string{}
+		for _, item := range history.GetItem() {
+			items[item.Key] = item.ValueJson
+		}
+		delete(items, "_runtime")
+		delete(items, "_step")
+		return items
+	}
+}
+
+// getSummary requests the summary from a handler.
+func getSummary(
+	inChan chan *service.Record,
+	outChan chan *service.Result,
+) map[string]string {
+	inChan <- &service.Record{RecordType: &service.Record_Request{
+		Request: &service.Request{RequestType: &service.Request_GetSummary{
+			GetSummary: &service.GetSummaryRequest{},
+		}},
+	}}
+
+	var response *service.GetSummaryResponse
+	for response == nil {
+		response = (<-outChan).GetResponse().GetGetSummaryResponse()
+	}
+	items := map[string]string{}
+	for _, item := range response.Item {
+		items[item.Key] = item.ValueJson
+	}
+	return items
+}
+
+func TestChainedStepMetricsWithGlob(t *testing.T) {
+	inChan, fwdChan, _ := makeMetricHandler()
+
+	inChan <- makeMetricRecord(stepMetric("epoch", "global_step"))
+	inChan <- makeMetricRecord(stepMetric("batch", "epoch"))
+	inChan <- makeMetricRecord(&service.MetricRecord{
+		GlobName:   "train/*",
+		StepMetric: "batch",
+		Options:    &service.MetricOptions{StepSync: true},
+	})
+
+	inChan <- makeHistoryRecord(data{items: map[string]string{
+		"global_step": "100",
+		"epoch":       "2",
+		"batch":       "7",
+	}, step: 0})
+	inChan <- makeHistoryRecord(data{items: map[string]string{
+		"train/loss": "0.5",
+	}, step: 1})
+	inChan <- makeHistoryRecord(data{items: map[string]string{
+		"train/acc": "0.9",
+		"batch":     "8",
+	}, step: 2})
+	inChan <- makeHistoryRecord(data{items: map[string]string{
+		"val/loss": "0.7",
+	}, step: 3})
+
+	nextHistory(fwdChan)
+	assert.Equal(t,
+		map[string]string{
+			"train/loss":  "0.5",
+			"batch":       "7",
+			"epoch":       "2",
+			"global_step": "100",
+		},
+		nextHistory(fwdChan))
+	assert.Equal(t,
+		map[string]string{
+			"train/acc":   "0.9",
+			"batch":       "8",
+			"epoch":       "2",
+			"global_step": "100",
+		},
+		nextHistory(fwdChan))
+	// metrics that match no definition sync no steps
+	assert.Equal(t,
+		map[string]string{"val/loss": "0.7"},
+		nextHistory(fwdChan))
+}
+
+func TestStepMetricCycle(t *testing.T) {
+	inChan, fwdChan, _ := makeMetricHandler()
+
+	inChan <- makeMetricRecord(stepMetric("a", "b"))
+	inChan <- makeMetricRecord(stepMetric("b", "c"))
+	inChan <- makeMetricRecord(stepMetric("c", "a"))
+
+	inChan <- makeHistoryRecord(data{items: map[string]string{
+		"a": "1",
+		"b": "2",
+		"c": "3",
+	}, step: 0})
+	inChan <- makeHistoryRecord(data{items: map[string]string{
+		"a": "4",
+	}, step: 1})
+
+	nextHistory(fwdChan)
+	assert.Equal(t,
+		map[string]string{"a": "4", "b": "2", "c": "3"},
+		nextHistory(fwdChan))
+}
+
+func TestMetricSummaries(t *testing.T) {
+	inChan, fwdChan, outChan := makeMetricHandler()
+
+	inChan <- makeMetricRecord(&service.MetricRecord{
+		Name: "loss",
+		Summary: &service.MetricSummary{
+			Min: true, Max: true, Mean: true, First: true, Last: true,
+		},
+	})
+	inChan <- makeMetricRecord(&service.MetricRecord{
+		Name:    "acc",
+		Summary: &service.MetricSummary{Best: true},
+		Goal:    service.MetricRecord_GOAL_MAXIMIZE,
+	})
+	inChan <- makeMetricRecord(&service.MetricRecord{
+		Name:    "hidden",
+		Summary: &service.MetricSummary{None: true},
+	})
+	inChan <- makeMetricRecord(&service.MetricRecord{
+		Name:    "epoch",
+		Summary: &service.MetricSummary{Max: true},
+	})
+	inChan <- makeMetricRecord(stepMetric("val", "epoch"))
+
+	inChan <- makeHistoryRecord(data{items: map[string]string{
+		"loss":   "3",
+		"acc":    "0.5",
+		"hidden": "1",
+		"epoch":  "1",
+		"lr":     "0.1",
+	}, step: 0})
+	inChan <- makeHistoryRecord(data{items: map[string]string{
+		"loss":  "NaN",
+		"acc":   "0.7",
+		"epoch": "2",
+	}, step: 1})
+	inChan <- makeHistoryRecord(data{items: map[string]string{
+		"loss": "1",
+		"acc":  "0.6",
+		"val":  "0.2",
+	}, step: 2})
+
+	nextHistory(fwdChan)
+	nextHistory(fwdChan)
+	// step metrics are synced from their latest value, not their summary
+	assert.Equal(t,
+		map[string]string{"loss": "1", "acc": "0.6", "val": "0.2", "epoch": "2"},
+		nextHistory(fwdChan))
+
+	summary := getSummary(inChan, outChan)
+	assert.Equal(t,
+		`{"first": 3, "last": 1, "max": 3, "mean": 2, "min": 1}`,
+		summary["loss"])
+	assert.Equal(t, `{"best": 0.7}`, summary["acc"])
+	assert.Equal(t, `{"max": 2}`, summary["epoch"])
+	assert.Equal(t, "0.1", summary["lr"])
+	assert.NotContains(t, summary, "hidden")
+}
@@ -8,12 +8,14 @@ import (
 	"path/filepath"
 	"strings"
 	"sync"
+	"sync/atomic"
 	"time"
 
 	"github.com/segmentio/encoding/json"
 
 	"github.com/Khan/genqlient/graphql"
 	"google.golang.org/protobuf/proto"
+	"google.golang.org/protobuf/types/known/timestamppb"
 	"google.golang.org/protobuf/types/known/wrapperspb"
 
 	"github.com/wandb/wandb/core/internal/api"
@@ -25,6 +27,7 @@ import (
 	"github.com/wandb/wandb/core/internal/runconfig"
 	"github.com/wandb/wandb/core/internal/runfiles"
 	"github.com/wandb/wandb/core/internal/runresume"
+	"github.com/wandb/wandb/core/internal/summarystore"
 	"github.com/wandb/wandb/core/internal/version"
 	"github.com/wandb/wandb/core/pkg/artifacts"
 	fs "github.com/wandb/wandb/core/pkg/filestream"
@@ -39,6 +42,26 @@ const (
 	RFC3339Micro             = "2006-01-02T15:04:05.000000Z07:00"
 	configDebouncerRateLimit = 1 / 30.0 // todo: audit rate limit
 	configDebouncerBurstSize = 1        // todo: audit burst size
+
+	checkpointDebouncerRateLimit = 1 / 5.0
+	checkpointDebouncerBurstSize = 1
+
+	// outputDebouncerRateLimit is how often lines that are still being
+	// written, such as progress bars, are sent
+	outputDebouncerRateLimit = 1 / 2.0
+	outputDebouncerBurstSize = 1
+
+	// maxOutputLineLength is the longest console output line, including
+	// its prefix, that the backend accepts
+	maxOutputLineLength = 60_000
+
+	// followPollInterval is how often to check for new records when
+	// syncing a log that is still being written
+	followPollInterval = time.Second
+
+	// followIdleTimeout is how long to wait for new records when syncing
+	// a log that is still being written before giving up on its writer
+	followIdleTimeout = 10 * time.Minute
 )
 
 type SenderOption func(*Sender)
@@ -55,6 +78,29 @@ func WithSenderOutChannel(out chan *service.Result) SenderOption {
 	}
 }
 
+func WithSenderCheckpoint(checkpoint *Checkpoint) SenderOption {
+	return func(s *Sender) {
+		s.checkpoint = checkpoint
+	}
+}
+
+// WithSenderResendFrom makes the sender skip the records of the transaction
+// log that the checkpoint of an unfinished run shows were already handled.
+func WithSenderResendFrom(state *CheckpointState) SenderOption {
+	return func(s *Sender) {
+		s.resendFrom = state
+	}
+}
+
+// WithSenderUploadErrors sets where the sender keeps the errors that kept
+// the run's data from being uploaded, which the run's files uploader also
+// reports to.
+func WithSenderUploadErrors(uploadErrors *uploadErrors) SenderOption {
+	return func(s *Sender) {
+		s.uploadErrors = uploadErrors
+	}
+}
+
 func WithSenderMailbox(mailbox *mailbox.Mailbox) SenderOption {
 	return func(s *Sender) {
 		s.mailbox = mailbox
@@ -88,6 +134,10 @@ type Sender struct {
 	// fileStream is the file stream
 	fileStream fs.FileStream
 
+	// runStopped is set when the filestream service reports that the run
+	// was stopped remotely
+	runStopped atomic.Bool
+
 	// filetransfer is the file uploader/downloader
 	fileTransferManager filetransfer.FileTransferManager
 
@@ -111,8 +161,15 @@ type Sender struct {
 	// debouncer for config updates
 	configDebouncer *debounce.Debouncer
 
-	// Keep track of summary which is being updated incrementally
-	summaryMap map[string]*service.SummaryItem
+	// console turns console output into the lines of the output file
+	console *outputConsole
+
+	// debouncer for sending output lines that are still being written
+	outputDebouncer *debounce.Debouncer
+
+	// summary is the run's summary, which is updated incrementally and
+	// spilled to disk like the handler's when it has many keys
+	summary *summarystore.Store
 
 	// Keep track of config which is being updated incrementally
 	runConfig *runconfig.RunConfig
@@ -127,6 +184,21 @@ type Sender struct {
 
 	store *Store
 
+	// checkpoint tracks the records handled by the sender, nil if the
+	// run is not persisted to a transaction log
+	checkpoint *Checkpoint
+
+	// debouncer for saving the checkpoint
+	checkpointDebouncer *debounce.Debouncer
+
+	// resendFrom is the checkpoint of an unfinished run whose records
+	// are being resent, nil unless resending
+	resendFrom *CheckpointState
+
+	// uploadErrors has the first error that kept the run's data from
+	// being uploaded, which is the result of syncing the run
+	uploadErrors *uploadErrors
+
 	jobBuilder *launch.JobBuilder
 
 	wgFileTransfer sync.WaitGroup
@@ -156,7 +228,6 @@ func NewSender(
 		cancel:              cancel,
 		settings:            settings,
 		logger:              logger,
-		summaryMap:          make(map[string]*service.SummaryItem),
 		runConfig:           runconfig.New(),
 		telemetry:           &service.TelemetryRecord{CoreVersion: version.Version},
 		wgFileTransfer:      sync.WaitGroup{},
@@ -165,16 +236,35 @@ func NewSender(
 		runfilesUploader:    runfilesUploaderOrNil,
 		networkPeeker:       peeker,
 		graphqlClient:       graphqlClient,
+		uploadErrors:        &uploadErrors{},
 	}
 
 	if !settings.GetXOffline().GetValue() && backendOrNil != nil && !settings.GetDisableJobCreation().GetValue() {
 		sender.jobBuilder = launch.NewJobBuilder(settings, logger, false)
 	}
+	sender.summary = summarystore.New(summarystore.Params{
+		Dir: settings.GetSyncDir().GetValue(),
+	})
 	sender.configDebouncer = debounce.NewDebouncer(
 		configDebouncerRateLimit,
 		configDebouncerBurstSize,
 		logger,
 	)
+	sender.checkpointDebouncer = debounce.NewDebouncer(
+		checkpointDebouncerRateLimit,
+		checkpointDebouncerBurstSize,
+		logger,
+	)
+	sender.console = newOutputConsole(
+		logger,
+		fileStreamOrNil,
+		filepath.Join(settings.GetFilesDir().GetValue(), OutputFileName),
+	)
+	sender.outputDebouncer = debounce.NewDebouncer(
+		outputDebouncerRateLimit,
+		outputDebouncerBurstSize,
+		logger,
+	)
 
 	for _, opt := range opts {
 		opt(sender)
@@ -197,6 +287,13 @@ func (s *Sender) Do(inChan <-chan *service.Record) {
 		s.sendRecord(record)
 		// TODO: reevaluate the logic here
 		s.configDebouncer.Debounce(s.upsertConfig)
+		s.outputDebouncer.Debounce(s.console.refresh)
+
+		if s.checkpoint != nil && record.GetNum() > 0 {
+			s.checkpoint.Handled(record.GetNum())
+			s.checkpointDebouncer.SetNeedsDebounce()
+			s.checkpointDebouncer.Debounce(s.saveCheckpoint)
+		}
 	}
 	s.Close()
 	s.logger.Info("sender: closed", "stream_id", s.settings.RunId)
@@ -205,6 +302,27 @@ func (s *Sender) Do(inChan <-chan *service.Record) {
 func (s *Sender) Close() {
 	// sender is done processing data, close our dispatch channel
 	close(s.outChan)
+	if err := s.summary.Close(); err != nil {
+		s.logger.CaptureError("sender: failed to close summary store", err)
+	}
+}
+
+// finishResend marks the checkpoint of a resent run as finished, so that
+// it is not resent again
+func (s *Sender) finishResend() {
+	checkpoint := NewCheckpoint(CheckpointPath(s.settings.GetSyncFile().GetValue()))
+	checkpoint.state = *s.resendFrom
+	checkpoint.Finish()
+	if err := checkpoint.Save(); err != nil {
+		s.logger.Error("sender: finishResend: failed to save checkpoint", "error", err)
+	}
+}
+
+// saveCheckpoint persists the records handled so far
+func (s *Sender) saveCheckpoint() {
+	if err := s.checkpoint.Save(); err != nil {
+		s.logger.Error("sender: saveCheckpoint: failed to save checkpoint", "error", err)
+	}
 }
 
 func (s *Sender) GetOutboundChannel() chan *service.Result {
@@ -340,6 +458,9 @@ func (s *Sender) sendRequestRunStart(_ *service.RunStartRequest) {
 	if s.fileStream != nil {
 		s.fileStream.SetPath(fsPath)
 		s.fileStream.SetOffsets(s.resumeState.GetFileStreamOffset())
+		s.fileStream.SetFeedbackHandler(s.handleFileStreamFeedback)
+		s.fileStream.SetDeliveredHandler(s.checkpoint.Delivered)
+		s.fileStream.SetErrorHandler(s.uploadErrors.add)
 		s.fileStream.Start()
 	}
 
@@ -348,6 +469,19 @@ func (s *Sender) sendRequestRunStart(_ *service.RunStartRequest) {
 	}
 }
 
+// handleFileStreamFeedback acts on the feedback from the filestream service.
+//
+// It is called from the filestream's goroutine. The filestream applies
+// limits and throttling itself.
+func (s *Sender) handleFileStreamFeedback(feedback fs.Feedback) {
+	if feedback.StopRun && !s.runStopped.Swap(true) {
+		s.logger.Info("sender: run was stopped remotely", "run_id", s.settings.GetRunId().GetValue())
+	}
+	if feedback.RetryAfter > 0 {
+		s.logger.Warn("sender: filestream is being throttled", "retry_after", feedback.RetryAfter)
+	}
+}
+
 func (s *Sender) sendRequestNetworkStatus(
 	record *service.Record,
 	_ *service.NetworkStatusRequest,
@@ -383,15 +517,17 @@ func (s *Sender) sendJobFlush() {
 	s.jobBuilder.SetRunConfig(*s.runConfig)
 	output := make(map[string]interface{})
 
-	var out interface{}
-	for k, v := range s.summaryMap {
-		bytes := []byte(v.GetValueJson())
-		err := json.Unmarshal(bytes, &out)
-		if err != nil {
-			s.logger.Error("sender: sendDefer: failed to unmarshal summary", "error", err)
-			return
+	err := s.summary.Range(func(key, value string) error {
+		var out interface{}
+		if err := json.Unmarshal([]byte(value), &out); err != nil {
+			return err
 		}
-		output[k] = out
+		output[key] = out
+		return nil
+	})
+	if err != nil {
+		s.logger.Error("sender: sendDefer: failed to read summary", "error", err)
+		return
 	}
 
 	artifact, err := s.jobBuilder.Build(output)
@@ -437,6 +573,8 @@ func (s *Sender) sendRequestDefer(request *service.DeferRequest) {
 		request.State++
 		s.fwdRequestDefer(request)
 	case service.DeferRequest_FLUSH_OUTPUT:
+		s.outputDebouncer.UnsetNeedsDebounce()
+		s.console.flush()
 		request.State++
 		s.fwdRequestDefer(request)
 	case service.DeferRequest_FLUSH_JOB:
@@ -468,6 +606,20 @@ func (s *Sender) sendRequestDefer(request *service.DeferRequest) {
 		s.fwdRequestDefer(request)
 	case service.DeferRequest_END:
 		request.State++
+		// a run whose data was not all uploaded is sent again later
+		uploadErr := s.uploadErrors.first()
+		if uploadErr != nil {
+			s.logger.Error("sender: not finishing checkpoint", "error", uploadErr)
+		}
+		if s.checkpoint != nil {
+			if uploadErr == nil {
+				s.checkpoint.Finish()
+			}
+			s.saveCheckpoint()
+		}
+		if s.resendFrom != nil && uploadErr == nil {
+			s.finishResend()
+		}
 		s.syncService.Flush()
 		s.respondExit(s.exitRecord)
 		// cancel tells the stream to close the loopback channel
@@ -500,7 +652,7 @@ func (s *Sender) sendPreempting(record *service.Record) {
 		return
 	}
 
-	s.fileStream.StreamRecord(record)
+	s.streamRecord(record)
 }
 
 func (s *Sender) sendLinkArtifact(record *service.Record) {
@@ -636,6 +788,7 @@ func (s *Sender) sendRun(record *service.Record, run *service.RunRecord) {
 
 			if err := s.checkAndUpdateResumeState(record); err != nil {
 				s.logger.Error("sender: sendRun: failed to checkAndUpdateResumeState", "error", err)
+				s.uploadErrors.add(err)
 				return
 			}
 		}
@@ -699,8 +852,7 @@ func (s *Sender) sendRun(record *service.Record, run *service.RunRecord) {
 			s.logger.Error("sender: sendRun:", "error", err)
 			// TODO(run update): handle error communication back to the client
 			fmt.Println("ERROR: failed to upsert bucket", err.Error())
-			// TODO(sync): make this more robust in case of a failed UpsertBucket request.
-			//  Need to inform the sync service that this ops failed.
+			s.uploadErrors.add(err)
 			if record.GetControl().GetReqResp() || record.GetControl().GetMailboxSlot() != "" {
 				result := &service.Result{
 					ResultType: &service.Result_RunResult{
@@ -753,38 +905,48 @@ func (s *Sender) sendHistory(record *service.Record, _ *service.HistoryRecord) {
 		return
 	}
 
-	s.fileStream.StreamRecord(record)
+	s.streamRecord(record)
 }
 
-func (s *Sender) sendSummary(_ *service.Record, summary *service.SummaryRecord) {
+func (s *Sender) sendSummary(record *service.Record, summary *service.SummaryRecord) {
 
-	// TODO(network): buffer summary sending for network efficiency until we can send only updates
-	// TODO(compat): handle deletes, nested keys
+	// the filestream only sends the newest of the summaries it has queued
 	// TODO(compat): write summary file
 
-	// track each key in the in memory summary store
-	// TODO(memory): avoid keeping summary for all distinct keys
+	// the handler resolves nested keys, so items are top-level keys
 	for _, item := range summary.Update {
-		s.summaryMap[item.Key] = item
+		if err := s.summary.Set(item.Key, item.ValueJson); err != nil {
+			// values that fail to spill stay in memory
+			s.logger.Debug("sender: failed to spill summary", "error", err)
+		}
+	}
+	for _, item := range summary.Remove {
+		s.summary.Delete(item.Key)
 	}
 
 	if s.fileStream != nil {
-		// build list of summary items from the map
-		var summaryItems []*service.SummaryItem
-		for _, v := range s.summaryMap {
-			summaryItems = append(summaryItems, v)
+		// every summary sent to the filestream is the whole summary
+		summaryItems := make([]*service.SummaryItem, 0, s.summary.Len())
+		err := s.summary.Range(func(key, value string) error {
+			summaryItems = append(summaryItems, &service.SummaryItem{
+				Key:       key,
+				ValueJson: value,
+			})
+			return nil
+		})
+		if err != nil {
+			s.logger.CaptureError("sender: failed to read summary", err)
+			return
 		}
 
-		// build a full summary record to send
-		record := &service.Record{
+		s.streamRecord(&service.Record{
+			Num: record.GetNum(),
 			RecordType: &service.Record_Summary{
 				Summary: &service.SummaryRecord{
 					Update: summaryItems,
 				},
 			},
-		}
-
-		s.fileStream.StreamRecord(record)
+		})
 	}
 }
 
@@ -865,11 +1027,29 @@ func (s *Sender) sendSystemMetrics(record *service.Record, _ *service.StatsRecor
 		return
 	}
 
+	s.streamRecord(record)
+}
+
+// streamRecord sends a record to the file stream; the checkpoint does not
+// count it as handled until the file stream delivers it
+func (s *Sender) streamRecord(record *service.Record) {
+	s.checkpoint.Streamed(record.GetNum())
 	s.fileStream.StreamRecord(record)
 }
 
-func (s *Sender) sendOutput(_ *service.Record, _ *service.OutputRecord) {
-	// TODO: implement me
+func (s *Sender) sendOutput(_ *service.Record, output *service.OutputRecord) {
+	var outputType service.OutputRawRecord_OutputType
+	switch output.OutputType {
+	case service.OutputRecord_STDOUT:
+		outputType = service.OutputRawRecord_STDOUT
+	case service.OutputRecord_STDERR:
+		outputType = service.OutputRawRecord_STDERR
+	default:
+		err := fmt.Errorf("unexpected output type %v", output.OutputType)
+		s.logger.CaptureError("sender: sendOutput: failed to write output", err)
+		return
+	}
+	s.writeOutput(outputType, output.Line, output.Timestamp)
 }
 
 func writeOutputToFile(file, line string) error {
@@ -884,51 +1064,31 @@ func writeOutputToFile(file, line string) error {
 	return err
 }
 
-func (s *Sender) sendOutputRaw(record *service.Record, outputRaw *service.OutputRawRecord) {
-	// TODO: match logic handling of lines to the one in the python version
-	// - handle carriage returns (for tqdm-like progress bars)
-	// - handle caching multiple (non-new lines) and sending them in one chunk
-	// - handle lines longer than ~60_000 characters
-
-	// ignore empty "new lines"
-	if outputRaw.Line == "\n" {
-		return
-	}
-
-	outputFile := filepath.Join(s.settings.GetFilesDir().GetValue(), OutputFileName)
-	// append line to file
-	if err := writeOutputToFile(outputFile, outputRaw.Line); err != nil {
-		s.logger.Error("sender: sendOutput: failed to write to output file", "error", err)
-	}
+func (s *Sender) sendOutputRaw(_ *service.Record, outputRaw *service.OutputRawRecord) {
+	s.writeOutput(outputRaw.OutputType, outputRaw.Line, outputRaw.Timestamp)
+}
 
-	if s.fileStream == nil {
-		return
+// writeOutput writes console output to the run's output file and sends it
+// to the filestream.
+//
+// Lines are stamped with the time the output was written, or with the
+// current time if the record has none.
+func (s *Sender) writeOutput(
+	outputType service.OutputRawRecord_OutputType,
+	output string,
+	timestamp *timestamppb.Timestamp,
+) {
+	at := time.Now()
+	if timestamp != nil {
+		at = timestamp.AsTime()
 	}
 
-	// generate compatible timestamp to python iso-format (microseconds without Z)
-	t := strings.TrimSuffix(time.Now().UTC().Format(RFC3339Micro), "Z")
-	var line string
-	switch outputRaw.OutputType {
-	case service.OutputRawRecord_STDOUT:
-		line = fmt.Sprintf("%s %s", t, outputRaw.Line)
-	case service.OutputRawRecord_STDERR:
-		line = fmt.Sprintf("ERROR %s %s", t, outputRaw.Line)
-	default:
-		err := fmt.Errorf("sender: sendOutputRaw: unexpected output type %v", outputRaw.OutputType)
-		s.logger.CaptureError("sender received error", err)
+	if err := s.console.write(outputType, output, at); err != nil {
+		s.logger.CaptureError("sender: writeOutput: failed to write output", err)
 		return
 	}
-	newRecord := &service.Record{
-		RecordType: &service.Record_OutputRaw{
-			OutputRaw: &service.OutputRawRecord{
-				Line: line,
-			},
-		},
-		Control: record.Control,
-		Uuid:    record.Uuid,
-		XInfo:   record.XInfo,
-	}
-	s.fileStream.StreamRecord(newRecord)
+	s.console.update()
+	s.outputDebouncer.SetNeedsDebounce()
 }
 
 func (s *Sender) sendAlert(_ *service.Record, alert *service.AlertRecord) {
@@ -1101,6 +1261,12 @@ func (s *Sender) sendRequestDownloadArtifact(record *service.Record, msg *servic
 }
 
 func (s *Sender) sendRequestSync(record *service.Record, request *service.SyncRequest) {
+	// the records of the previous upload went to the original run, so a
+	// run moved elsewhere gets all of them
+	overwrite := request.GetOverwrite()
+	if overwrite.GetRunId() != "" || overwrite.GetEntity() != "" || overwrite.GetProject() != "" {
+		s.resendFrom = nil
+	}
 
 	s.syncService = NewSyncService(s.ctx,
 		WithSyncServiceLogger(s.logger),
@@ -1108,6 +1274,11 @@ func (s *Sender) sendRequestSync(record *service.Record, request *service.SyncRe
 		WithSyncServiceOverwrite(request.GetOverwrite()),
 		WithSyncServiceSkip(request.GetSkip()),
 		WithSyncServiceFlushCallback(func(err error) {
+			// the log was read, but not all of it may have reached the server
+			if err == nil {
+				err = s.uploadErrors.first()
+			}
+
 			var errorInfo *service.ErrorInfo
 			if err != nil {
 				errorInfo = &service.ErrorInfo{
@@ -1148,6 +1319,7 @@ func (s *Sender) sendRequestSync(record *service.Record, request *service.SyncRe
 					SenderRead: &service.SenderReadRequest{
 						StartOffset: request.GetStartOffset(),
 						FinalOffset: request.GetFinalOffset(),
+						Follow:      request.GetFollow(),
 					},
 				},
 			},
@@ -1167,8 +1339,14 @@ func (s *Sender) sendRequestStopStatus(record *service.Record, _ *service.StopSt
 
 	var stopResponse *service.StopStatusResponse
 
-	// if any of the entity, project or runId is empty, we can't make the request
-	if entity == "" || project == "" || runId == "" {
+	// the filestream may already have told us, in which case the run stays
+	// stopped and there is no need to ask
+	if s.runStopped.Load() {
+		stopResponse = &service.StopStatusResponse{
+			RunShouldStop: true,
+		}
+	} else if entity == "" || project == "" || runId == "" {
+		// if any of the entity, project or runId is empty, we can't make the request
 		s.logger.Error("sender: sendStopStatus: entity, project, runId are empty")
 		stopResponse = &service.StopStatusResponse{
 			RunShouldStop: false,
@@ -1205,39 +1383,93 @@ func (s *Sender) sendRequestStopStatus(record *service.Record, _ *service.StopSt
 	s.outChan <- result
 }
 
-func (s *Sender) sendRequestSenderRead(_ *service.Record, _ *service.SenderReadRequest) {
+// sendRequestSenderRead replays the records of the transaction log in the
+// range given by the request, either to the sync service or to the sender
+func (s *Sender) sendRequestSenderRead(_ *service.Record, request *service.SenderReadRequest) {
 	if s.store == nil {
 		store := NewStore(s.ctx, s.settings.GetSyncFile().GetValue(), s.logger)
 		err := store.Open(os.O_RDONLY)
 		if err != nil {
 			s.logger.CaptureError("sender: sendSenderRead: failed to create store", err)
+			if s.settings.GetXSync().GetValue() {
+				// finish the sync so that the error is reported
+				s.syncService.SyncRecord(nil, err)
+			}
 			return
 		}
 		s.store = store
 	}
-	// TODO:
-	// 1. seek to startOffset
-	//
-	// if err := s.store.reader.SeekRecord(request.GetStartOffset()); err != nil {
-	// 	s.logger.CaptureError("sender: sendSenderRead: failed to seek record", err)
-	// 	return
-	// }
-	// 2. read records until finalOffset
-	//
-	for {
-		record, err := s.store.Read()
+
+	replay := func(record *service.Record) {
 		if s.settings.GetXSync().GetValue() {
-			s.syncService.SyncRecord(record, err)
-		} else if record != nil {
+			s.syncService.SyncRecord(record, nil)
+		} else {
 			s.sendRecord(record)
 		}
-		if err == io.EOF {
-			return
+	}
+
+	startOffset := request.GetStartOffset()
+	alreadyHandled := 0
+	if s.resendFrom != nil {
+		// the run record is needed to set up the run again, even if the
+		// previous process already handled it
+		if s.resendFrom.RunOffset < s.resendFrom.Offset {
+			_, err := s.store.Replay(
+				s.resendFrom.RunOffset,
+				s.resendFrom.RunOffset+1,
+				replay,
+			)
+			if err != nil {
+				s.logger.CaptureError("sender: sendSenderRead: failed to read run record", err)
+			}
 		}
-		if err != nil {
-			s.logger.CaptureError("sender: sendSenderRead: failed to read record", err)
+		startOffset = s.resendFrom.Offset
+	}
+
+	handle := func(record *service.Record) {
+		if s.resendFrom != nil && record.GetNum() <= s.resendFrom.Num {
+			alreadyHandled++
 			return
 		}
+		replay(record)
+	}
+
+	var stats ReplayStats
+	var err error
+	if request.GetFollow() {
+		// the log is still being written, read it until the run exits
+		stats, err = s.store.Follow(
+			startOffset,
+			followPollInterval,
+			followIdleTimeout,
+			func(record *service.Record) bool {
+				handle(record)
+				return record.GetExit() == nil
+			},
+		)
+	} else {
+		stats, err = s.store.Replay(startOffset, request.GetFinalOffset(), handle)
+	}
+	s.logger.Info(
+		"sender: sendSenderRead: finished reading records",
+		"start_offset", startOffset,
+		"final_offset", request.GetFinalOffset(),
+		"read", stats.Read,
+		"already_handled", alreadyHandled,
+		"skipped", stats.Skipped,
+		"corrupt", stats.Corrupt,
+	)
+	if err != nil {
+		s.logger.CaptureError("sender: sendSenderRead: failed to read record", err)
+	} else if stats.Corrupt > 0 {
+		err = fmt.Errorf("sender: sendSenderRead: found %d corrupt records", stats.Corrupt)
+	}
+
+	if s.settings.GetXSync().GetValue() {
+		if err == nil {
+			err = io.EOF
+		}
+		s.syncService.SyncRecord(nil, err)
 	}
 }
 
@@ -2,7 +2,13 @@ package server_test
 
 import (
 	"context"
+	"fmt"
+	"os"
+	"path/filepath"
+	"strings"
 	"testing"
+	"time"
+	"unicode/utf8"
 
 	"github.com/Khan/genqlient/graphql"
 	"github.com/golang/mock/gomock"
@@ -11,9 +17,12 @@ import (
 	"github.com/wandb/wandb/core/internal/gqlmock"
 	"github.com/wandb/wandb/core/internal/mailbox"
 	wbsettings "github.com/wandb/wandb/core/internal/settings"
+	"github.com/wandb/wandb/core/pkg/filestream"
+	"github.com/wandb/wandb/core/pkg/filestreamtest"
 	"github.com/wandb/wandb/core/pkg/observability"
 	"github.com/wandb/wandb/core/pkg/server"
 	"github.com/wandb/wandb/core/pkg/service"
+	"google.golang.org/protobuf/types/known/timestamppb"
 	"google.golang.org/protobuf/types/known/wrapperspb"
 )
 
@@ -63,6 +72,7 @@ func makeSender(client graphql.Client, resultChan chan *service.Result) *server.
 		fileStream,
 		fileTransferManager,
 		client,
+		nil, /* onError */
 	)
 	sender := server.NewSender(
 		ctx,
@@ -82,6 +92,29 @@ func makeSender(client graphql.Client, resultChan chan *service.Result) *server.
 	return sender
 }
 
+func makeSenderWithFileStream(
+	client graphql.Client,
+	resultChan chan *service.Result,
+	fileStream *filestreamtest.FakeFileStream,
+	settings *service.Settings,
+) *server.Sender {
+	return server.NewSender(
+		context.Background(),
+		func() {},
+		nil, /* backend */
+		fileStream,
+		nil, /* fileTransferManager */
+		observability.NewNoOpLogger(),
+		nil, /* runfilesUploader */
+		settings,
+		nil, /* peeker */
+		client,
+		server.WithSenderFwdChannel(make(chan *service.Record, 1)),
+		server.WithSenderOutChannel(resultChan),
+		server.WithSenderMailbox(mailbox.NewMailbox()),
+	)
+}
+
 // Verify that project and entity are properly passed through to graphql
 func TestSendRun(t *testing.T) {
 	mockGQL := gqlmock.NewMockClient()
@@ -123,6 +156,230 @@ func TestSendRun(t *testing.T) {
 		requests[0])
 }
 
+// Verify that a stop from the filestream service is reported without
+// asking the server again
+func TestSendStopStatusFromFileStreamFeedback(t *testing.T) {
+	mockGQL := gqlmock.NewMockClient()
+	mockGQL.StubMatchOnce(
+		gqlmock.WithOpName("UpsertBucket"),
+		validUpsertBucketResponse,
+	)
+	resultChan := make(chan *service.Result, 1)
+	fakeFileStream := filestreamtest.NewFakeFileStream()
+	sender := makeSenderWithFileStream(
+		mockGQL,
+		resultChan,
+		fakeFileStream,
+		&service.Settings{RunId: &wrapperspb.StringValue{Value: "run1"}},
+	)
+
+	sender.SendRecord(&service.Record{
+		RecordType: &service.Record_Run{Run: &service.RunRecord{
+			Config:  &service.ConfigRecord{},
+			Project: "testProject",
+			Entity:  "testEntity",
+			RunId:   "run1",
+		}},
+		Control: &service.Control{MailboxSlot: "junk"},
+	})
+	<-resultChan
+	sender.SendRecord(&service.Record{
+		RecordType: &service.Record_Request{Request: &service.Request{
+			RequestType: &service.Request_RunStart{RunStart: &service.RunStartRequest{}},
+		}},
+	})
+
+	fakeFileStream.SendFeedback(filestream.Feedback{StopRun: true})
+	sender.SendRecord(&service.Record{
+		RecordType: &service.Record_Request{Request: &service.Request{
+			RequestType: &service.Request_StopStatus{StopStatus: &service.StopStatusRequest{}},
+		}},
+	})
+
+	result := <-resultChan
+	assert.True(t, result.GetResponse().GetStopStatusResponse().GetRunShouldStop())
+	// only UpsertBucket, no RunStoppedStatus
+	assert.Len(t, mockGQL.AllRequests(), 1)
+}
+
+// Verify that every summary sent to the filestream is the whole summary
+func TestSendSummaryStreamsWholeSummary(t *testing.T) {
+	fakeFileStream := filestreamtest.NewFakeFileStream()
+	sender := makeSenderWithFileStream(
+		gqlmock.NewMockClient(),
+		make(chan *service.Result, 1),
+		fakeFileStream,
+		&service.Settings{SyncDir: &wrapperspb.StringValue{Value: t.TempDir()}},
+	)
+	defer sender.Close()
+
+	sendSummary := func(summary *service.SummaryRecord) {
+		sender.SendRecord(&service.Record{
+			RecordType: &service.Record_Summary{Summary: summary},
+		})
+	}
+	sendSummary(&service.SummaryRecord{Update: []*service.SummaryItem{
+		{Key: "a", ValueJson: "1"},
+		{Key: "b", ValueJson: "2"},
+	}})
+	sendSummary(&service.SummaryRecord{
+		Update: []*service.SummaryItem{{Key: "c", ValueJson: "3"}},
+		Remove: []*service.SummaryItem{{Key: "a"}},
+	})
+
+	records := fakeFileStream.GetRecords()
+	assert.Len(t, records, 2)
+	var items []string
+	for _, item := range records[1].GetSummary().GetUpdate() {
+		items = append(items, item.Key+"="+item.ValueJson)
+	}
+	assert.Equal(t, []string{"b=2", "c=3"}, items)
+}
+
+func sendOutputRaw(sender *server.Sender, outputType service.OutputRawRecord_OutputType, line string) {
+	sender.SendRecord(&service.Record{
+		RecordType: &service.Record_OutputRaw{OutputRaw: &service.OutputRawRecord{
+			OutputType: outputType,
+			Line:       line,
+		}},
+	})
+}
+
+func flushOutput(sender *server.Sender) {
+	sender.SendRecord(&service.Record{
+		RecordType: &service.Record_Request{Request: &service.Request{
+			RequestType: &service.Request_Defer{Defer: &service.DeferRequest{
+				State: service.DeferRequest_FLUSH_OUTPUT,
+			}},
+		}},
+	})
+}
+
+// withoutPrefix removes the stream and timestamp prefix of output lines.
+func withoutPrefix(lines []string) []string {
+	result := make([]string, len(lines))
+	for i, line := range lines {
+		// "[ERROR ]<timestamp> <line>"
+		_, result[i], _ = strings.Cut(strings.TrimPrefix(line, "ERROR "), " ")
+	}
+	return result
+}
+
+// Verify that long output lines are wrapped for the filestream
+func TestSendOutputRawWrapsLongLines(t *testing.T) {
+	fakeFileStream := filestreamtest.NewFakeFileStream()
+	sender := makeSenderWithFileStream(
+		gqlmock.NewMockClient(),
+		make(chan *service.Result, 1),
+		fakeFileStream,
+		&service.Settings{FilesDir: &wrapperspb.StringValue{Value: t.TempDir()}},
+	)
+
+	line := strings.Repeat("x", 70_000) + strings.Repeat("é", 30_000)
+	sendOutputRaw(sender, service.OutputRawRecord_STDERR, line+"\n")
+
+	output := fakeFileStream.GetOutput()
+	assert.Greater(t, len(output), 1)
+	for _, part := range output {
+		assert.LessOrEqual(t, len(part), 60_000)
+		assert.True(t, utf8.ValidString(part))
+		assert.True(t, strings.HasPrefix(part, "ERROR "))
+	}
+	assert.Equal(t, line, strings.Join(withoutPrefix(output), ""))
+}
+
+// Verify that rewritten lines are sent as the lines they end up as
+func TestSendOutputRawCollapsesProgressBars(t *testing.T) {
+	filesDir := t.TempDir()
+	fakeFileStream := filestreamtest.NewFakeFileStream()
+	sender := makeSenderWithFileStream(
+		gqlmock.NewMockClient(),
+		make(chan *service.Result, 1),
+		fakeFileStream,
+		&service.Settings{FilesDir: &wrapperspb.StringValue{Value: filesDir}},
+	)
+
+	sendOutputRaw(sender, service.OutputRawRecord_STDOUT, "training")
+	sendOutputRaw(sender, service.OutputRawRecord_STDOUT, "\n")
+	for i := 0; i <= 100; i++ {
+		sendOutputRaw(sender, service.OutputRawRecord_STDERR, fmt.Sprintf("\r%3d%%", i))
+	}
+	sendOutputRaw(sender, service.OutputRawRecord_STDERR, "\n")
+	sendOutputRaw(sender, service.OutputRawRecord_STDOUT, "\x1b[1A\x1b[2Kdone")
+	flushOutput(sender)
+
+	output := fakeFileStream.GetOutput()
+	assert.Equal(t, []string{"done", "100%"}, withoutPrefix(output))
+	assert.True(t, strings.HasPrefix(output[1], "ERROR "))
+
+	outputFile, err := os.ReadFile(filepath.Join(filesDir, "output.log"))
+	assert.NoError(t, err)
+	assert.Equal(t, "done\n100%\n", string(outputFile))
+}
+
+// Verify that output and raw output records are written the same way
+func TestSendOutputRecords(t *testing.T) {
+	at := time.Date(2024, 1, 2, 3, 4, 5, 6000, time.UTC)
+	records := map[string]func(stderr bool, line string) *service.Record{
+		"OutputRecord": func(stderr bool, line string) *service.Record {
+			outputType := service.OutputRecord_STDOUT
+			if stderr {
+				outputType = service.OutputRecord_STDERR
+			}
+			return &service.Record{RecordType: &service.Record_Output{
+				Output: &service.OutputRecord{
+					OutputType: outputType,
+					Timestamp:  timestamppb.New(at),
+					Line:       line,
+				},
+			}}
+		},
+		"OutputRawRecord": func(stderr bool, line string) *service.Record {
+			outputType := service.OutputRawRecord_STDOUT
+			if stderr {
+				outputType = service.OutputRawRecord_STDERR
+			}
+			return &service.Record{RecordType: &service.Record_OutputRaw{
+				OutputRaw: &service.OutputRawRecord{
+					OutputType: outputType,
+					Timestamp:  timestamppb.New(at),
+					Line:       line,
+				},
+			}}
+		},
+	}
+
+	for name, record := range records {
+		t.Run(name, func(t *testing.T) {
+			filesDir := t.TempDir()
+			fakeFileStream := filestreamtest.NewFakeFileStream()
+			sender := makeSenderWithFileStream(
+				gqlmock.NewMockClient(),
+				make(chan *service.Result, 1),
+				fakeFileStream,
+				&service.Settings{FilesDir: &wrapperspb.StringValue{Value: filesDir}},
+			)
+
+			sender.SendRecord(record(false, "epoch 1"))
+			sender.SendRecord(record(false, "\n"))
+			sender.SendRecord(record(true, "warning\n"))
+			sender.SendRecord(record(false, "epoch 2\n"))
+			flushOutput(sender)
+
+			assert.Equal(t,
+				[]string{
+					"2024-01-02T03:04:05.000006 epoch 1",
+					"ERROR 2024-01-02T03:04:05.000006 warning",
+					"2024-01-02T03:04:05.000006 epoch 2",
+				},
+				fakeFileStream.GetOutput())
+			outputFile, err := os.ReadFile(filepath.Join(filesDir, "output.log"))
+			assert.NoError(t, err)
+			assert.Equal(t, "epoch 1\nwarning\nepoch 2\n", string(outputFile))
+		})
+	}
+}
+
 // Verify that arguments are properly passed through to graphql
 func TestSendLinkArtifact(t *testing.T) {
 	mockGQL := gqlmock.NewMockClient()
@@ -3,9 +3,14 @@ package server
 import (
 	"context"
 	"encoding/binary"
+	"errors"
 	"fmt"
 	"io"
+	"math"
 	"os"
+	"sort"
+
+	"github.com/segmentio/encoding/json"
 
 	"github.com/wandb/wandb/core/pkg/observability"
 
@@ -23,8 +28,12 @@ type HeaderOptions struct {
 const (
 	// headerMagic is the magic number for the header.
 	headerMagic = 0xBEE1
-	// headerVersion is the version of the header.
+	// headerVersion is the version of the header of a store whose
+	// records are raw protobuf.
 	headerVersion = 0
+	// headerVersionCompressed is the version of the header of a store
+	// whose records start with their Compression byte.
+	headerVersionCompressed = 1
 )
 
 // headerIdent returns the header identifier.
@@ -60,10 +69,180 @@ func (o *HeaderOptions) UnmarshalBinary(r io.Reader) error {
 
 // Valid checks if the header is valid based on a reference header.
 func (o *HeaderOptions) Valid() bool {
-	return o.IDENT == headerIdent() && o.Magic == headerMagic && o.Version == headerVersion
+	return o.IDENT == headerIdent() && o.Magic == headerMagic && o.Version <= headerVersionCompressed
+}
+
+const (
+	// manifestSuffix is appended to the transaction log name to get the
+	// name of its manifest, which only exists if the log has segments
+	manifestSuffix = ".manifest"
+)
+
+// StoreSegment is a file of a segmented transaction log.
+type StoreSegment struct {
+	// Offset is the offset of the segment's first record in the log
+	//
+	// Offsets of records in the segment are relative to it, so that
+	// record offsets keep growing across segments.
+	Offset int64 `json:"offset"`
+}
+
+// StoreManifest lists the segments of a transaction log.
+//
+// The first segment is the file named after the log, segment i > 0 is
+// the file with the suffix ".i". Each segment starts with its own header.
+type StoreManifest struct {
+	Segments []StoreSegment `json:"segments"`
+}
+
+// ManifestPath returns the path of the manifest of a transaction log.
+func ManifestPath(name string) string {
+	return name + manifestSuffix
+}
+
+// SegmentPath returns the path of the i-th segment of a transaction log.
+func SegmentPath(name string, i int) string {
+	if i == 0 {
+		return name
+	}
+	return fmt.Sprintf("%s.%d", name, i)
+}
+
+// LoadStoreManifest reads the manifest of a transaction log.
+//
+// A log without a manifest consists of a single segment.
+func LoadStoreManifest(name string) (*StoreManifest, error) {
+	data, err := os.ReadFile(ManifestPath(name))
+	if os.IsNotExist(err) {
+		return &StoreManifest{Segments: []StoreSegment{{Offset: 0}}}, nil
+	}
+	if err != nil {
+		return nil, err
+	}
+
+	manifest := &StoreManifest{}
+	if err := json.Unmarshal(data, manifest); err != nil {
+		return nil, fmt.Errorf("store: failed to parse manifest of %s: %v", name, err)
+	}
+	if len(manifest.Segments) == 0 {
+		return nil, fmt.Errorf("store: manifest of %s has no segments", name)
+	}
+	return manifest, nil
+}
+
+// save writes the manifest of the transaction log with the given name.
+func (m *StoreManifest) save(name string) error {
+	data, err := json.Marshal(m)
+	if err != nil {
+		return err
+	}
+
+	tmpPath := ManifestPath(name) + ".tmp"
+	if err := os.WriteFile(tmpPath, data, 0644); err != nil {
+		return err
+	}
+	return os.Rename(tmpPath, ManifestPath(name))
+}
+
+// segmentAt returns the index of the segment containing the offset.
+func (m *StoreManifest) segmentAt(offset int64) int {
+	i := sort.Search(len(m.Segments), func(i int) bool {
+		return m.Segments[i].Offset > offset
+	})
+	return max(i-1, 0)
+}
+
+// RemoveStore deletes all files of a transaction log.
+func RemoveStore(name string) error {
+	manifest, err := LoadStoreManifest(name)
+	if err != nil {
+		return err
+	}
+	for i := range manifest.Segments {
+		if err := os.Remove(SegmentPath(name, i)); err != nil && !os.IsNotExist(err) {
+			return err
+		}
+	}
+	if err := os.Remove(ManifestPath(name)); err != nil && !os.IsNotExist(err) {
+		return err
+	}
+	return nil
+}
+
+// RenameStore moves all files of a transaction log to a new name,
+// replacing any log with that name.
+//
+// Each file is renamed over the one it replaces, and the segments of the
+// replaced log that are left over are only removed at the end, so that an
+// error or a crash part way never loses both logs. The manifest is moved
+// after the segments, so a reader never sees a manifest that refers to
+// segments that do not exist yet.
+func RenameStore(oldName, newName string) error {
+	manifest, err := LoadStoreManifest(oldName)
+	if err != nil {
+		return err
+	}
+	replaced, err := LoadStoreManifest(newName)
+	if err != nil {
+		return err
+	}
+
+	// a manifest of the replaced log must be replaced too, even if the new
+	// log has a single segment
+	_, err = os.Stat(ManifestPath(newName))
+	hasManifest := len(manifest.Segments) > 1 || err == nil
+	if hasManifest {
+		if err := manifest.save(oldName); err != nil {
+			return err
+		}
+	}
+
+	for i := range manifest.Segments {
+		if err := os.Rename(SegmentPath(oldName, i), SegmentPath(newName, i)); err != nil {
+			return err
+		}
+	}
+	if hasManifest {
+		if err := os.Rename(ManifestPath(oldName), ManifestPath(newName)); err != nil {
+			return err
+		}
+	}
+
+	for i := len(manifest.Segments); i < len(replaced.Segments); i++ {
+		err := os.Remove(SegmentPath(newName, i))
+		if err != nil && !os.IsNotExist(err) {
+			return err
+		}
+	}
+	return nil
+}
+
+type StoreOption func(*Store)
+
+// WithStoreSegmentSize starts a new segment once a segment reaches the
+// given size in bytes.
+//
+// A size of zero, the default, writes the whole log into a single file.
+func WithStoreSegmentSize(size int64) StoreOption {
+	return func(s *Store) {
+		s.segmentSize = size
+	}
+}
+
+// WithStoreCompression compresses the records written to the store.
+//
+// Compressed stores have a version 1 header, which older readers reject.
+func WithStoreCompression(compression Compression) StoreOption {
+	return func(s *Store) {
+		s.codec.compression = compression
+	}
 }
 
 // Store is the persistent store for a stream
+//
+// A store opened for writing with a segment size splits the log into
+// segments listed by a manifest. A store opened for reading reads across
+// all segments, and record offsets are the same as in a single file log.
 type Store struct {
 	// ctx is the context for the store
 	ctx context.Context
@@ -82,13 +261,40 @@ type Store struct {
 
 	// logger is the logger for the store
 	logger *observability.CoreLogger
+
+	// segmentSize is the size after which the writer starts a new segment
+	segmentSize int64
+
+	// manifest lists the segments of the log
+	manifest *StoreManifest
+
+	// segment is the index of the open segment
+	segment int
+
+	// lastOffset is the offset of the last record written, or -1
+	lastOffset int64
+
+	// version is the header version of the open segment
+	version byte
+
+	// codec compresses and decompresses records of version 1 segments
+	codec recordCodec
 }
 
 // NewStore creates a new store
-func NewStore(ctx context.Context, fileName string, logger *observability.CoreLogger) *Store {
+func NewStore(
+	ctx context.Context,
+	fileName string,
+	logger *observability.CoreLogger,
+	opts ...StoreOption,
+) *Store {
 	sr := &Store{ctx: ctx,
-		name:   fileName,
-		logger: logger,
+		name:       fileName,
+		logger:     logger,
+		lastOffset: -1,
+	}
+	for _, opt := range opts {
+		opt(sr)
 	}
 	return sr
 }
@@ -97,38 +303,22 @@ func NewStore(ctx context.Context, fileName string, logger *observability.CoreLo
 func (sr *Store) Open(flag int) error {
 	switch flag {
 	case os.O_RDONLY:
-		f, err := os.Open(sr.name)
+		manifest, err := LoadStoreManifest(sr.name)
 		if err != nil {
-			sr.logger.CaptureError("can't open file", err)
+			sr.logger.CaptureError("can't read manifest", err)
 			return err
 		}
-		sr.db = f
-		sr.reader = leveldb.NewReaderExt(f, leveldb.CRCAlgoIEEE)
-		header := NewHeader()
-		if err := header.UnmarshalBinary(sr.db); err != nil {
-			sr.logger.CaptureError("can't read header", err)
-			return err
-		}
-		if !header.Valid() {
-			err := fmt.Errorf("invalid header")
-			sr.logger.CaptureError("can't read header", err)
-			return err
-		}
-		return nil
+		sr.manifest = manifest
+		return sr.openSegmentReader(0)
 	case os.O_WRONLY:
-		f, err := os.Create(sr.name)
-		if err != nil {
-			sr.logger.CaptureError("can't open file", err)
-			return err
-		}
-		sr.db = f
-		sr.writer = leveldb.NewWriterExt(f, leveldb.CRCAlgoIEEE)
-		header := NewHeader()
-		if err := header.MarshalBinary(sr.db); err != nil {
-			sr.logger.CaptureError("can't write header", err)
+		// a manifest left by a previous log with the same name would
+		// make readers look for segments that do not belong to this log
+		if err := RemoveStore(sr.name); err != nil {
+			sr.logger.CaptureError("can't remove previous file", err)
 			return err
 		}
-		return nil
+		sr.manifest = &StoreManifest{Segments: []StoreSegment{{Offset: 0}}}
+		return sr.openSegmentWriter(0)
 	default:
 		// TODO: generalize this?
 		err := fmt.Errorf("invalid flag %d", flag)
@@ -137,6 +327,104 @@ func (sr *Store) Open(flag int) error {
 	}
 }
 
+// openSegmentReader opens the i-th segment for reading.
+func (sr *Store) openSegmentReader(i int) error {
+	if sr.db != nil {
+		if err := sr.db.Close(); err != nil {
+			sr.logger.CaptureError("can't close file", err)
+		}
+		sr.db = nil
+	}
+
+	f, err := os.Open(SegmentPath(sr.name, i))
+	if err != nil {
+		sr.logger.CaptureError("can't open file", err)
+		return err
+	}
+	sr.db = f
+	sr.segment = i
+	header := NewHeader()
+	if err := header.UnmarshalBinary(sr.db); err != nil {
+		sr.logger.CaptureError("can't read header", err)
+		return err
+	}
+	if !header.Valid() {
+		err := fmt.Errorf("invalid header")
+		sr.logger.CaptureError("can't read header", err)
+		return err
+	}
+	sr.version = header.Version
+	// the records start right after the header, read them through a
+	// section so that record offsets match the ones reported by the writer
+	headerSize := int64(binary.Size(header))
+	records := io.NewSectionReader(f, headerSize, math.MaxInt64-headerSize)
+	sr.reader = leveldb.NewReaderExt(records, leveldb.CRCAlgoIEEE)
+	return nil
+}
+
+// openSegmentWriter creates the i-th segment for writing.
+func (sr *Store) openSegmentWriter(i int) error {
+	f, err := os.Create(SegmentPath(sr.name, i))
+	if err != nil {
+		sr.logger.CaptureError("can't open file", err)
+		return err
+	}
+	sr.db = f
+	sr.segment = i
+	sr.writer = leveldb.NewWriterExt(f, leveldb.CRCAlgoIEEE)
+	header := NewHeader()
+	if sr.codec.compression != CompressionNone {
+		header.Version = headerVersionCompressed
+	}
+	sr.version = header.Version
+	if err := header.MarshalBinary(sr.db); err != nil {
+		sr.logger.CaptureError("can't write header", err)
+		return err
+	}
+	return nil
+}
+
+// rotate closes the open segment and continues the log in a new one.
+func (sr *Store) rotate() error {
+	if err := sr.writer.Close(); err != nil {
+		return err
+	}
+	// the next segment continues where the records of this one end
+	offset, err := sr.segmentEnd()
+	if err != nil {
+		return err
+	}
+	if err := sr.db.Close(); err != nil {
+		return err
+	}
+	sr.db = nil
+
+	if err := sr.openSegmentWriter(sr.segment + 1); err != nil {
+		return err
+	}
+	sr.manifest.Segments = append(sr.manifest.Segments, StoreSegment{Offset: offset})
+	return sr.manifest.save(sr.name)
+}
+
+// segmentOffset returns the offset of the open segment in the log.
+func (sr *Store) segmentOffset() int64 {
+	if sr.manifest == nil {
+		return 0
+	}
+	return sr.manifest.Segments[sr.segment].Offset
+}
+
+// segmentEnd returns the offset at which the records of the open
+// segment end.
+func (sr *Store) segmentEnd() (int64, error) {
+	info, err := sr.db.Stat()
+	if err != nil {
+		return 0, err
+	}
+	headerSize := int64(binary.Size(NewHeader()))
+	return sr.segmentOffset() + info.Size() - headerSize, nil
+}
+
 // Close closes the store
 func (sr *Store) Close() error {
 	if sr.writer != nil {
@@ -146,6 +434,8 @@ func (sr *Store) Close() error {
 		}
 	}
 
+	sr.codec.close()
+
 	err := sr.db.Close()
 	if err != nil {
 		sr.logger.CaptureError("can't close file", err)
@@ -165,47 +455,207 @@ func (sr *Store) Write(msg *service.Record) error {
 		sr.logger.CaptureError("can't write header", err)
 		return err
 	}
+	if sr.version == headerVersionCompressed {
+		if out, err = sr.codec.encode(out); err != nil {
+			sr.logger.CaptureError("can't compress record", err)
+			return err
+		}
+	}
 
 	if _, err = writer.Write(out); err != nil {
 		sr.logger.CaptureError("can't write header", err)
 		return err
 	}
+
+	offset, err := sr.writer.LastRecordOffset()
+	if err != nil {
+		sr.logger.CaptureError("can't get record offset", err)
+		return err
+	}
+	sr.lastOffset = sr.segmentOffset() + offset
+
+	if sr.segmentSize > 0 && offset+int64(len(out)) >= sr.segmentSize {
+		if err := sr.rotate(); err != nil {
+			sr.logger.CaptureError("can't start new segment", err)
+			return err
+		}
+	}
 	return nil
 }
 
+// LastRecordOffset returns the offset of the last record written to the store.
+//
+// The offset is relative to the end of the header and can be passed to
+// SeekRecord when the store is opened for reading.
+func (sr *Store) LastRecordOffset() (int64, error) {
+	if sr.writer == nil {
+		return 0, fmt.Errorf("store is not open for writing")
+	}
+	if sr.lastOffset < 0 {
+		return 0, leveldb.ErrNoLastRecord
+	}
+	return sr.lastOffset, nil
+}
+
 func (sr *Store) WriteDirectlyToDB(data []byte) (int, error) {
 	// this is for testing purposes only
 	return sr.db.Write(data)
 }
 
 func (sr *Store) Read() (*service.Record, error) {
+	msg, err := sr.read()
+	if err != nil && err != io.EOF {
+		sr.logger.CaptureError("can't read record", err)
+	}
+	return msg, err
+}
+
+// read is Read without logging errors.
+func (sr *Store) read() (*service.Record, error) {
 	// check if db is closed
 	if sr.db == nil {
-		err := fmt.Errorf("db is closed")
-		sr.logger.CaptureError("can't read record", err)
-		return nil, err
+		return nil, fmt.Errorf("db is closed")
 	}
 
 	reader, err := sr.reader.Next()
+	for err == io.EOF && sr.segment+1 < len(sr.manifest.Segments) {
+		// continue with the next segment of the log
+		if err := sr.openSegmentReader(sr.segment + 1); err != nil {
+			return nil, err
+		}
+		reader, err = sr.reader.Next()
+	}
 	if err == io.EOF {
 		return nil, err
 	}
 
 	if err != nil {
-		sr.logger.CaptureError("can't read record", err)
 		sr.reader.Recover()
 		return nil, err
 	}
 	buf, err := io.ReadAll(reader)
 	if err != nil {
-		sr.logger.CaptureError("can't read record", err)
 		sr.reader.Recover()
 		return nil, err
 	}
+	if sr.version == headerVersionCompressed {
+		if buf, err = sr.codec.decode(buf); err != nil {
+			return nil, err
+		}
+	}
 	msg := &service.Record{}
 	if err = proto.Unmarshal(buf, msg); err != nil {
-		sr.logger.CaptureError("can't read record", err)
 		return nil, err
 	}
 	return msg, nil
 }
+
+// SeekRecord moves the reader so that the next call to Read returns the
+// record starting at the given offset, as reported by LastRecordOffset.
+func (sr *Store) SeekRecord(offset int64) error {
+	if sr.reader == nil {
+		return fmt.Errorf("store is not open for reading")
+	}
+	if i := sr.manifest.segmentAt(offset); i != sr.segment {
+		if err := sr.openSegmentReader(i); err != nil {
+			return err
+		}
+	}
+	return sr.reader.SeekRecord(offset - sr.segmentOffset())
+}
+
+// lastReadOffset returns the offset of the last record returned by Read.
+func (sr *Store) lastReadOffset() (int64, error) {
+	offset, err := sr.reader.LastRecordOffset()
+	if err != nil {
+		return 0, err
+	}
+	return sr.segmentOffset() + offset, nil
+}
+
+// ReplayStats summarizes the records visited by Replay.
+type ReplayStats struct {
+	// Read is the number of records passed to the callback
+	Read int
+
+	// Skipped is the number of valid records read outside the requested range
+	Skipped int
+
+	// Corrupt is the number of records that could not be read or decoded
+	Corrupt int
+}
+
+// Replay reads the records in the range [startOffset, finalOffset) and
+// passes them to fn in order.
+//
+// A zero finalOffset means the replay runs until the end of the store.
+// If the reader can't seek to startOffset, the store is scanned from the
+// beginning and the records before startOffset are skipped. Corrupt records
+// are counted and skipped; reading resumes at the next valid block.
+func (sr *Store) Replay(
+	startOffset int64,
+	finalOffset int64,
+	fn func(*service.Record),
+) (ReplayStats, error) {
+	stats := ReplayStats{}
+
+	if sr.reader == nil {
+		return stats, fmt.Errorf("store is not open for reading")
+	}
+
+	// clear any error left by a previous read before seeking
+	sr.reader.Recover()
+	err := sr.SeekRecord(startOffset)
+	if err != nil && err != io.EOF && startOffset > 0 {
+		sr.logger.Warn(
+			"store: Replay: can't seek, scanning from the start",
+			"offset", startOffset,
+			"error", err,
+		)
+		sr.reader.Recover()
+		err = sr.SeekRecord(0)
+	}
+	var pathErr *os.PathError
+	switch {
+	case err == io.EOF:
+		// the offset may be at the end of a segment, Read moves on
+		// to the next one
+	case errors.As(err, &pathErr):
+		return stats, err
+	case err != nil:
+		// the block we landed in is corrupt, continue from the next one
+		stats.Corrupt++
+		sr.reader.Recover()
+	}
+
+	for {
+		record, err := sr.Read()
+		if err == io.EOF || errors.Is(err, io.ErrUnexpectedEOF) {
+			// a truncated last block means the writer did not finish
+			// writing it, there is nothing more to read
+			return stats, nil
+		}
+		if err != nil {
+			if errors.As(err, &pathErr) {
+				return stats, err
+			}
+			stats.Corrupt++
+			continue
+		}
+
+		offset, err := sr.lastReadOffset()
+		if err != nil {
+			return stats, err
+		}
+		if finalOffset > 0 && offset >= finalOffset {
+			return stats, nil
+		}
+		if offset < startOffset {
+			stats.Skipped++
+			continue
+		}
+
+		stats.Read++
+		fn(record)
+	}
+}
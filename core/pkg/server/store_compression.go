@@ -0,0 +1,127 @@
+package server
+
+import (
+	"fmt"
+
+	"github.com/klauspost/compress/s2"
+	"github.com/klauspost/compress/zstd"
+)
+
+// Compression is the algorithm used to compress the records of a store.
+//
+// In a store with a version 1 header, every record starts with the
+// Compression byte of the algorithm used for it.
+type Compression byte
+
+const (
+	CompressionNone Compression = iota
+	CompressionZstd
+	CompressionSnappy
+)
+
+// ParseCompression returns the compression algorithm with the given name.
+//
+// An empty name means no compression.
+func ParseCompression(name string) (Compression, error) {
+	switch name {
+	case "", "none":
+		return CompressionNone, nil
+	case "zstd":
+		return CompressionZstd, nil
+	case "snappy":
+		return CompressionSnappy, nil
+	default:
+		return CompressionNone, fmt.Errorf("store: unknown compression %q", name)
+	}
+}
+
+func (c Compression) String() string {
+	switch c {
+	case CompressionNone:
+		return "none"
+	case CompressionZstd:
+		return "zstd"
+	case CompressionSnappy:
+		return "snappy"
+	default:
+		return fmt.Sprintf("Compression(%d)", byte(c))
+	}
+}
+
+// recordCodec encodes and decodes the records of a store.
+type recordCodec struct {
+	// compression is the algorithm used to encode records
+	compression Compression
+
+	// encoder is the zstd encoder, created on first use
+	encoder *zstd.Encoder
+
+	// decoder is the zstd decoder, created on first use
+	decoder *zstd.Decoder
+}
+
+// encode returns the compressed record prefixed by its compression byte.
+//
+// Records that do not get smaller are stored uncompressed.
+func (c *recordCodec) encode(data []byte) ([]byte, error) {
+	out := make([]byte, 1, len(data)+1)
+
+	switch c.compression {
+	case CompressionZstd:
+		if c.encoder == nil {
+			encoder, err := zstd.NewWriter(nil, zstd.WithEncoderConcurrency(1))
+			if err != nil {
+				return nil, err
+			}
+			c.encoder = encoder
+		}
+		out = c.encoder.EncodeAll(data, out)
+	case CompressionSnappy:
+		out = append(out, s2.EncodeSnappy(nil, data)...)
+	}
+
+	if c.compression == CompressionNone || len(out)-1 >= len(data) {
+		out = append(out[:1], data...)
+		out[0] = byte(CompressionNone)
+		return out, nil
+	}
+	out[0] = byte(c.compression)
+	return out, nil
+}
+
+// decode returns the record encoded by encode.
+func (c *recordCodec) decode(data []byte) ([]byte, error) {
+	if len(data) == 0 {
+		return nil, fmt.Errorf("store: record is missing its compression byte")
+	}
+
+	switch Compression(data[0]) {
+	case CompressionNone:
+		return data[1:], nil
+	case CompressionZstd:
+		if c.decoder == nil {
+			decoder, err := zstd.NewReader(nil, zstd.WithDecoderConcurrency(1))
+			if err != nil {
+				return nil, err
+			}
+			c.decoder = decoder
+		}
+		return c.decoder.DecodeAll(data[1:], nil)
+	case CompressionSnappy:
+		return s2.Decode(nil, data[1:])
+	default:
+		return nil, fmt.Errorf("store: unknown record compression %d", data[0])
+	}
+}
+
+// close releases the resources of the codec.
+func (c *recordCodec) close() {
+	if c.encoder != nil {
+		_ = c.encoder.Close()
+		c.encoder = nil
+	}
+	if c.decoder != nil {
+		c.decoder.Close()
+		c.decoder = nil
+	}
+}
@@ -0,0 +1,150 @@
+package server
+
+import (
+	"encoding/binary"
+	"errors"
+	"fmt"
+	"io"
+	"os"
+	"time"
+
+	"github.com/wandb/wandb/core/pkg/service"
+)
+
+// storeBlockSize is the size of the blocks of the leveldb log format.
+const storeBlockSize = 32 * 1024
+
+// ErrFollowIdle is returned by Follow when the log does not grow for
+// longer than its idle timeout.
+var ErrFollowIdle = errors.New("store: no new records")
+
+// Follow reads the records of a log that may still be written to, like
+// `tail -f`, starting at startOffset.
+//
+// It calls fn for every record and returns once fn returns false, or with
+// ctx's error once ctx is done. When there is nothing more to read, it
+// checks for new records every pollInterval, and gives up with
+// ErrFollowIdle if there are none for idleTimeout. An idleTimeout of zero
+// waits forever.
+//
+// The writer adds whole blocks to the log, so the last block of the log
+// may be cut off or end in the middle of a record. Follow treats a log
+// that ends in the middle of a record, and errors in a last block that is
+// not full, as records that are not written yet and reads them again
+// later, while other errors in full blocks are corruption and are skipped
+// like in Replay.
+func (sr *Store) Follow(
+	startOffset int64,
+	pollInterval time.Duration,
+	idleTimeout time.Duration,
+	fn func(*service.Record) bool,
+) (ReplayStats, error) {
+	stats := ReplayStats{}
+	lastRecordTime := time.Now()
+
+	// resumeOffset is the offset of the last record passed to fn, which
+	// is read again and skipped when resuming after the end of the log
+	resumeOffset := int64(-1)
+	// corruptBlock is the offset of the last corrupt block reported, so
+	// that blocks read again after resuming are reported once
+	corruptBlock := int64(-1)
+	if err := sr.resume(startOffset, resumeOffset); err != nil {
+		return stats, err
+	}
+
+	for {
+		record, err := sr.read()
+		var pathErr *os.PathError
+		switch {
+		case errors.As(err, &pathErr):
+			return stats, err
+		case err == io.EOF || errors.Is(err, io.ErrUnexpectedEOF) ||
+			(err != nil && sr.atTail()):
+			if idleTimeout > 0 && time.Since(lastRecordTime) > idleTimeout {
+				return stats, fmt.Errorf("%w for %v", ErrFollowIdle, idleTimeout)
+			}
+			if err := sr.waitForRecords(pollInterval); err != nil {
+				return stats, err
+			}
+			if err := sr.resume(startOffset, resumeOffset); err != nil {
+				return stats, err
+			}
+			continue
+		case err != nil:
+			block := sr.segmentOffset() + sr.reader.BlockOffset()
+			if block > corruptBlock {
+				corruptBlock = block
+				sr.logger.CaptureError("store: Follow: skipping corrupt record", err)
+				stats.Corrupt++
+			}
+			continue
+		}
+
+		offset, err := sr.lastReadOffset()
+		if err != nil {
+			return stats, err
+		}
+		if offset <= resumeOffset {
+			// already passed to fn before reaching the end of the log
+			continue
+		}
+		resumeOffset = offset
+		lastRecordTime = time.Now()
+
+		stats.Read++
+		if !fn(record) {
+			return stats, nil
+		}
+	}
+}
+
+// atTail returns whether the reader is in the last block of the log and
+// the block is not full, so the writer may not have finished writing it.
+func (sr *Store) atTail() bool {
+	if sr.segment+1 < len(sr.manifest.Segments) {
+		return false
+	}
+
+	info, err := sr.db.Stat()
+	if err != nil {
+		return false
+	}
+	headerSize := int64(binary.Size(HeaderOptions{}))
+	return info.Size()-headerSize < sr.reader.BlockOffset()+storeBlockSize
+}
+
+// waitForRecords waits for the writer to add to the log.
+func (sr *Store) waitForRecords(pollInterval time.Duration) error {
+	select {
+	case <-sr.ctx.Done():
+		return sr.ctx.Err()
+	case <-time.After(pollInterval):
+	}
+
+	// the writer may have started new segments
+	manifest, err := LoadStoreManifest(sr.name)
+	if err != nil {
+		return err
+	}
+	sr.manifest = manifest
+	return nil
+}
+
+// resume positions the reader to read the records after the one at
+// resumeOffset, or from startOffset if no record was read yet.
+func (sr *Store) resume(startOffset, resumeOffset int64) error {
+	offset := startOffset
+	if resumeOffset >= 0 {
+		offset = resumeOffset
+	}
+
+	sr.reader.Recover()
+	err := sr.SeekRecord(offset)
+	var pathErr *os.PathError
+	if errors.As(err, &pathErr) {
+		return err
+	}
+	// other errors are left in the reader and returned by the next read,
+	// which waits again if they are due to the end of the log
+	return nil
+}
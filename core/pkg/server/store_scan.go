@@ -0,0 +1,193 @@
+package server
+
+import (
+	"context"
+	"errors"
+	"io"
+	"os"
+
+	"github.com/wandb/wandb/core/pkg/observability"
+	"github.com/wandb/wandb/core/pkg/service"
+)
+
+// OffsetRange is a range [Start, End) of store offsets.
+type OffsetRange struct {
+	Start int64 `json:"start"`
+	End   int64 `json:"end"`
+}
+
+// NumRange is a range [First, Last] of record numbers.
+type NumRange struct {
+	First int64 `json:"first"`
+	Last  int64 `json:"last"`
+}
+
+// ScanReport describes what could and could not be read from a
+// transaction log.
+type ScanReport struct {
+	// Records is the number of valid records
+	Records int `json:"records"`
+
+	// Errors is the number of read errors
+	//
+	// A corrupt block can cause more than one error, and the reader can
+	// skip several corrupt blocks after an error without reporting them.
+	Errors int `json:"errors"`
+
+	// CorruptRanges are the ranges of the log that could not be read
+	//
+	// A range starts at the block where reading failed and ends at the
+	// next valid record, or at the end of the log.
+	CorruptRanges []OffsetRange `json:"corrupt_ranges,omitempty"`
+
+	// MissingRecords are the ranges of record numbers absent from the log
+	//
+	// Gaps are not corruption on their own: compacted logs leave out the
+	// records they merge. Records lost at the end of the log are not known
+	// and not listed.
+	MissingRecords []NumRange `json:"missing_records,omitempty"`
+
+	// Truncated is whether the log ends in a partially written record
+	Truncated bool `json:"truncated"`
+
+	// LastNum is the number of the last valid record
+	LastNum int64 `json:"last_num"`
+
+	// HasHeader is whether the log has a header record
+	HasHeader bool `json:"has_header"`
+
+	// HasRun is whether the log has a run record
+	HasRun bool `json:"has_run"`
+
+	// HasExit is whether the log has an exit record
+	HasExit bool `json:"has_exit"`
+}
+
+// Clean returns whether the whole log could be read.
+//
+// Missing record numbers do not make a log unclean, since compaction
+// leaves gaps in the numbers by design.
+func (r *ScanReport) Clean() bool {
+	return r.Errors == 0 && !r.Truncated
+}
+
+// ScanStore reads the whole transaction log and reports what was lost.
+//
+// Every valid record is passed to fn, which may be nil.
+func ScanStore(
+	ctx context.Context,
+	logger *observability.CoreLogger,
+	name string,
+	fn func(*service.Record),
+) (*ScanReport, error) {
+	store := NewStore(ctx, name, logger)
+	if err := store.Open(os.O_RDONLY); err != nil {
+		return nil, err
+	}
+	defer store.Close()
+
+	return store.scan(fn)
+}
+
+// RepairStore writes a copy of the transaction log src to dst that contains
+// only its valid records, and reports what was lost.
+//
+// The records keep their numbers, so the report of the copy lists the
+// same missing records but no corruption.
+func RepairStore(
+	ctx context.Context,
+	logger *observability.CoreLogger,
+	src string,
+	dst string,
+	opts ...StoreOption,
+) (*ScanReport, error) {
+	out := NewStore(ctx, dst, logger, opts...)
+	if err := out.Open(os.O_WRONLY); err != nil {
+		return nil, err
+	}
+
+	var writeErr error
+	report, err := ScanStore(ctx, logger, src, func(record *service.Record) {
+		if writeErr == nil {
+			writeErr = out.Write(record)
+		}
+	})
+
+	if closeErr := out.Close(); closeErr != nil && writeErr == nil {
+		writeErr = closeErr
+	}
+	if err != nil {
+		return report, err
+	}
+	return report, writeErr
+}
+
+// scan reads the store from its current position to the end.
+func (sr *Store) scan(fn func(*service.Record)) (*ScanReport, error) {
+	report := &ScanReport{}
+	corruptStart := int64(-1)
+
+	for {
+		record, err := sr.Read()
+		if err == io.EOF {
+			break
+		}
+
+		var pathErr *os.PathError
+		switch {
+		case errors.As(err, &pathErr):
+			return report, err
+		case errors.Is(err, io.ErrUnexpectedEOF):
+			report.Truncated = true
+			continue
+		case err != nil:
+			report.Errors++
+			if corruptStart < 0 {
+				corruptStart = sr.segmentOffset() + sr.reader.BlockOffset()
+			}
+			continue
+		}
+
+		offset, err := sr.lastReadOffset()
+		if err != nil {
+			return report, err
+		}
+		if corruptStart >= 0 {
+			report.CorruptRanges = append(report.CorruptRanges,
+				OffsetRange{Start: corruptStart, End: offset})
+			corruptStart = -1
+		}
+
+		report.Records++
+		if record.Num > report.LastNum+1 {
+			report.MissingRecords = append(report.MissingRecords,
+				NumRange{First: report.LastNum + 1, Last: record.Num - 1})
+		}
+		if record.Num > report.LastNum {
+			report.LastNum = record.Num
+		}
+
+		switch record.RecordType.(type) {
+		case *service.Record_Header:
+			report.HasHeader = true
+		case *service.Record_Run:
+			report.HasRun = true
+		case *service.Record_Exit:
+			report.HasExit = true
+		}
+
+		if fn != nil {
+			fn(record)
+		}
+	}
+
+	if corruptStart >= 0 {
+		end, err := sr.segmentEnd()
+		if err != nil {
+			return report, err
+		}
+		report.CorruptRanges = append(report.CorruptRanges,
+			OffsetRange{Start: corruptStart, End: end})
+	}
+	return report, nil
+}
@@ -2,9 +2,12 @@ package server_test
 
 import (
 	"context"
+	"encoding/binary"
 	"io"
 	"os"
+	"path/filepath"
 	"testing"
+	"time"
 
 	"github.com/stretchr/testify/assert"
 	"github.com/wandb/wandb/core/pkg/observability"
@@ -240,3 +243,611 @@ func TestReadFromClosedStore(t *testing.T) {
 	_, err = store.Read()
 	assert.Error(t, err, "can't read record")
 }
+
+// TestReplayRange tests that the store can replay a range of records using
+// the offsets reported while writing.
+func TestReplayRange(t *testing.T) {
+	tmpFile, err := os.CreateTemp("", "temp-db")
+	assert.NoError(t, err)
+	defer os.Remove(tmpFile.Name())
+	tmpFile.Close()
+
+	logger := observability.NewNoOpLogger()
+	store := server.NewStore(context.Background(), tmpFile.Name(), logger)
+	err = store.Open(os.O_WRONLY)
+	assert.NoError(t, err)
+
+	var offsets []int64
+	for i := 1; i <= 10; i++ {
+		err = store.Write(&service.Record{Num: int64(i), Uuid: "test-uuid"})
+		assert.NoError(t, err)
+		offset, err := store.LastRecordOffset()
+		assert.NoError(t, err)
+		offsets = append(offsets, offset)
+	}
+	err = store.Close()
+	assert.NoError(t, err)
+
+	replay := func(start, final int64) ([]int64, server.ReplayStats) {
+		store := server.NewStore(context.Background(), tmpFile.Name(), logger)
+		err := store.Open(os.O_RDONLY)
+		assert.NoError(t, err)
+		defer store.Close()
+
+		var nums []int64
+		stats, err := store.Replay(start, final, func(record *service.Record) {
+			nums = append(nums, record.Num)
+		})
+		assert.NoError(t, err)
+		return nums, stats
+	}
+
+	nums, stats := replay(0, 0)
+	assert.Equal(t, []int64{1, 2, 3, 4, 5, 6, 7, 8, 9, 10}, nums)
+	assert.Equal(t, server.ReplayStats{Read: 10}, stats)
+
+	nums, stats = replay(offsets[6], 0)
+	assert.Equal(t, []int64{7, 8, 9, 10}, nums)
+	assert.Equal(t, server.ReplayStats{Read: 4}, stats)
+
+	nums, stats = replay(offsets[2], offsets[5])
+	assert.Equal(t, []int64{3, 4, 5}, nums)
+	assert.Equal(t, server.ReplayStats{Read: 3}, stats)
+}
+
+// TestReplayCorruptRecord tests that a replay keeps going after a corrupt
+// record and counts it.
+func TestReplayCorruptRecord(t *testing.T) {
+	tmpFile, err := os.CreateTemp("", "temp-db")
+	assert.NoError(t, err)
+	defer os.Remove(tmpFile.Name())
+	tmpFile.Close()
+
+	logger := observability.NewNoOpLogger()
+	store := server.NewStore(context.Background(), tmpFile.Name(), logger)
+	err = store.Open(os.O_WRONLY)
+	assert.NoError(t, err)
+
+	// spread the records over several blocks, so that corrupting the first
+	// block leaves the later ones readable
+	payload := string(make([]byte, 20000))
+	for i := 1; i <= 6; i++ {
+		err = store.Write(&service.Record{Num: int64(i), Uuid: payload})
+		assert.NoError(t, err)
+	}
+	err = store.Close()
+	assert.NoError(t, err)
+
+	// flip a byte in the payload of the first record to break its checksum
+	data, err := os.ReadFile(tmpFile.Name())
+	assert.NoError(t, err)
+	data[100] ^= 0xff
+	err = os.WriteFile(tmpFile.Name(), data, 0644)
+	assert.NoError(t, err)
+
+	store2 := server.NewStore(context.Background(), tmpFile.Name(), logger)
+	err = store2.Open(os.O_RDONLY)
+	assert.NoError(t, err)
+	defer store2.Close()
+
+	var nums []int64
+	stats, err := store2.Replay(0, 0, func(record *service.Record) {
+		nums = append(nums, record.Num)
+	})
+	assert.NoError(t, err)
+	assert.Equal(t, 1, stats.Corrupt)
+	assert.NotEmpty(t, nums)
+	assert.NotContains(t, nums, int64(1))
+	assert.Equal(t, int64(6), nums[len(nums)-1])
+}
+
+// TestSegmentedStore tests that a store split into segments reads and
+// replays like a single file.
+func TestSegmentedStore(t *testing.T) {
+	name := filepath.Join(t.TempDir(), "run-test.wandb")
+	logger := observability.NewNoOpLogger()
+
+	store := server.NewStore(context.Background(), name, logger,
+		server.WithStoreSegmentSize(50000))
+	err := store.Open(os.O_WRONLY)
+	assert.NoError(t, err)
+
+	payload := string(make([]byte, 20000))
+	var offsets []int64
+	for i := 1; i <= 10; i++ {
+		err = store.Write(&service.Record{Num: int64(i), Uuid: payload})
+		assert.NoError(t, err)
+		offset, err := store.LastRecordOffset()
+		assert.NoError(t, err)
+		offsets = append(offsets, offset)
+	}
+	err = store.Close()
+	assert.NoError(t, err)
+
+	manifest, err := server.LoadStoreManifest(name)
+	assert.NoError(t, err)
+	assert.Len(t, manifest.Segments, 4)
+	assert.FileExists(t, server.SegmentPath(name, 3))
+	assert.IsIncreasing(t, offsets)
+
+	store2 := server.NewStore(context.Background(), name, logger)
+	err = store2.Open(os.O_RDONLY)
+	assert.NoError(t, err)
+	defer store2.Close()
+
+	for i := 1; i <= 10; i++ {
+		record, err := store2.Read()
+		assert.NoError(t, err)
+		assert.Equal(t, int64(i), record.Num)
+	}
+	_, err = store2.Read()
+	assert.Equal(t, io.EOF, err)
+
+	var nums []int64
+	stats, err := store2.Replay(offsets[4], offsets[8], func(record *service.Record) {
+		nums = append(nums, record.Num)
+	})
+	assert.NoError(t, err)
+	assert.Equal(t, []int64{5, 6, 7, 8}, nums)
+	assert.Equal(t, server.ReplayStats{Read: 4}, stats)
+}
+
+func TestRenameStoreOverSegmentedStore(t *testing.T) {
+	dir := t.TempDir()
+	name := filepath.Join(dir, "run-test.wandb")
+	compacted := filepath.Join(dir, "run-test.wandb.compact")
+	logger := observability.NewNoOpLogger()
+
+	write := func(name string, segmentSize int64, records int) {
+		store := server.NewStore(context.Background(), name, logger,
+			server.WithStoreSegmentSize(segmentSize))
+		assert.NoError(t, store.Open(os.O_WRONLY))
+		payload := string(make([]byte, 20000))
+		for i := 1; i <= records; i++ {
+			assert.NoError(t, store.Write(&service.Record{Num: int64(i), Uuid: payload}))
+		}
+		assert.NoError(t, store.Close())
+	}
+	write(name, 50000, 10)
+	write(compacted, 0, 2)
+
+	assert.NoError(t, server.RenameStore(compacted, name))
+
+	// the segments of the replaced log are gone, and the log reads as the
+	// one that replaced it
+	manifest, err := server.LoadStoreManifest(name)
+	assert.NoError(t, err)
+	assert.Len(t, manifest.Segments, 1)
+	assert.NoFileExists(t, server.SegmentPath(name, 1))
+	assert.NoFileExists(t, compacted)
+
+	store := server.NewStore(context.Background(), name, logger)
+	assert.NoError(t, store.Open(os.O_RDONLY))
+	defer store.Close()
+	var nums []int64
+	_, err = store.Replay(0, 0, func(record *service.Record) {
+		nums = append(nums, record.Num)
+	})
+	assert.NoError(t, err)
+	assert.Equal(t, []int64{1, 2}, nums)
+}
+
+// TestFollowStore tests reading a log while it is being written.
+func TestFollowStore(t *testing.T) {
+	name := filepath.Join(t.TempDir(), "run-test.wandb")
+	logger := observability.NewNoOpLogger()
+
+	writer := server.NewStore(context.Background(), name, logger,
+		server.WithStoreSegmentSize(100000))
+	assert.NoError(t, writer.Open(os.O_WRONLY))
+
+	// records span blocks, so the reader sees records cut off at the
+	// end of the last block written so far
+	payload := string(make([]byte, 10000))
+	go func() {
+		for i := 1; i <= 30; i++ {
+			assert.NoError(t, writer.Write(&service.Record{Num: int64(i), Uuid: payload}))
+			time.Sleep(time.Millisecond)
+		}
+		assert.NoError(t, writer.Write(&service.Record{
+			Num:        31,
+			RecordType: &service.Record_Exit{Exit: &service.RunExitRecord{}},
+		}))
+		assert.NoError(t, writer.Close())
+	}()
+
+	reader := server.NewStore(context.Background(), name, logger)
+	assert.NoError(t, reader.Open(os.O_RDONLY))
+	defer reader.Close()
+
+	var nums []int64
+	stats, err := reader.Follow(0, time.Millisecond, 0, func(record *service.Record) bool {
+		nums = append(nums, record.Num)
+		return record.GetExit() == nil
+	})
+	assert.NoError(t, err)
+	assert.Equal(t, 31, stats.Read)
+	assert.Equal(t, 0, stats.Corrupt)
+	for i, num := range nums {
+		assert.Equal(t, int64(i+1), num)
+	}
+}
+
+// TestFollowStoreCanceled tests that following a log stops with its context.
+func TestFollowStoreCanceled(t *testing.T) {
+	name := filepath.Join(t.TempDir(), "run-test.wandb")
+	logger := observability.NewNoOpLogger()
+
+	writer := server.NewStore(context.Background(), name, logger)
+	assert.NoError(t, writer.Open(os.O_WRONLY))
+	assert.NoError(t, writer.Write(&service.Record{Num: 1}))
+	assert.NoError(t, writer.Close())
+
+	ctx, cancel := context.WithTimeout(context.Background(), 50*time.Millisecond)
+	defer cancel()
+	reader := server.NewStore(ctx, name, logger)
+	assert.NoError(t, reader.Open(os.O_RDONLY))
+	defer reader.Close()
+
+	stats, err := reader.Follow(0, time.Millisecond, 0, func(*service.Record) bool {
+		return true
+	})
+	assert.ErrorIs(t, err, context.DeadlineExceeded)
+	assert.Equal(t, 1, stats.Read)
+}
+
+// TestFollowStoreIdle tests that following a log stops once its writer
+// stops adding to it.
+func TestFollowStoreIdle(t *testing.T) {
+	name := filepath.Join(t.TempDir(), "run-test.wandb")
+	logger := observability.NewNoOpLogger()
+
+	writer := server.NewStore(context.Background(), name, logger)
+	assert.NoError(t, writer.Open(os.O_WRONLY))
+	assert.NoError(t, writer.Write(&service.Record{Num: 1}))
+	assert.NoError(t, writer.Close())
+
+	reader := server.NewStore(context.Background(), name, logger)
+	assert.NoError(t, reader.Open(os.O_RDONLY))
+	defer reader.Close()
+
+	stats, err := reader.Follow(0, time.Millisecond, 20*time.Millisecond,
+		func(*service.Record) bool { return true })
+	assert.ErrorIs(t, err, server.ErrFollowIdle)
+	assert.Equal(t, 1, stats.Read)
+}
+
+// TestFollowStoreCorruptLastBlock tests that corruption in a full last
+// block is skipped rather than waited on.
+func TestFollowStoreCorruptLastBlock(t *testing.T) {
+	name := filepath.Join(t.TempDir(), "run-test.wandb")
+	logger := observability.NewNoOpLogger()
+
+	writer := server.NewStore(context.Background(), name, logger)
+	assert.NoError(t, writer.Open(os.O_WRONLY))
+	payload := string(make([]byte, 1000))
+	var corruptOffset int64
+	for i := 1; i <= 40; i++ {
+		assert.NoError(t, writer.Write(&service.Record{Num: int64(i), Uuid: payload}))
+		if i == 5 {
+			offset, err := writer.LastRecordOffset()
+			assert.NoError(t, err)
+			corruptOffset = offset
+		}
+	}
+	assert.NoError(t, writer.Close())
+
+	// keep exactly one full block, with a corrupt record in it
+	headerSize := int64(binary.Size(server.HeaderOptions{}))
+	f, err := os.OpenFile(name, os.O_RDWR, 0)
+	assert.NoError(t, err)
+	assert.NoError(t, f.Truncate(headerSize+32*1024))
+	_, err = f.WriteAt([]byte{0xff}, headerSize+corruptOffset+100)
+	assert.NoError(t, err)
+	assert.NoError(t, f.Close())
+
+	reader := server.NewStore(context.Background(), name, logger)
+	assert.NoError(t, reader.Open(os.O_RDONLY))
+	defer reader.Close()
+
+	stats, err := reader.Follow(0, time.Millisecond, 50*time.Millisecond,
+		func(*service.Record) bool { return true })
+	assert.ErrorIs(t, err, server.ErrFollowIdle)
+	assert.Equal(t, 4, stats.Read)
+	assert.Equal(t, 1, stats.Corrupt)
+}
+
+// TestCompactStore tests that compaction folds summary and config records
+// into the last record of each kind.
+func TestCompactStore(t *testing.T) {
+	dir := t.TempDir()
+	src := filepath.Join(dir, "run-test.wandb")
+	dst := filepath.Join(dir, "run-test.wandb.compact")
+	logger := observability.NewNoOpLogger()
+
+	config := func(num int64, key, valueJson string) *service.Record {
+		return &service.Record{Num: num, RecordType: &service.Record_Config{
+			Config: &service.ConfigRecord{
+				Update: []*service.ConfigItem{{Key: key, ValueJson: valueJson}},
+			},
+		}}
+	}
+	summary := func(num int64, key, valueJson string) *service.Record {
+		return &service.Record{Num: num, RecordType: &service.Record_Summary{
+			Summary: &service.SummaryRecord{
+				Update: []*service.SummaryItem{{Key: key, ValueJson: valueJson}},
+			},
+		}}
+	}
+	removeConfig := &service.Record{Num: 7, RecordType: &service.Record_Config{
+		Config: &service.ConfigRecord{
+			Remove: []*service.ConfigItem{{Key: "b"}},
+		},
+	}}
+
+	store := server.NewStore(context.Background(), src, logger)
+	assert.NoError(t, store.Open(os.O_WRONLY))
+	for _, record := range []*service.Record{
+		{Num: 1, RecordType: &service.Record_Run{Run: &service.RunRecord{}}},
+		config(2, "a", "1"),
+		summary(3, "loss", "0.5"),
+		config(4, "b", "2"),
+		{Num: 5, RecordType: &service.Record_History{History: &service.HistoryRecord{}}},
+		summary(6, "loss", "0.25"),
+		removeConfig,
+		summary(8, "acc", "0.9"),
+		config(9, "a", "3"),
+	} {
+		assert.NoError(t, store.Write(record))
+	}
+	assert.NoError(t, store.Close())
+
+	stats, err := server.CompactStore(context.Background(), logger, src, dst)
+	assert.NoError(t, err)
+	assert.Equal(t, server.CompactStats{Read: 9, Written: 4}, stats)
+
+	compacted := server.NewStore(context.Background(), dst, logger)
+	assert.NoError(t, compacted.Open(os.O_RDONLY))
+	defer compacted.Close()
+
+	var records []*service.Record
+	_, err = compacted.Replay(0, 0, func(record *service.Record) {
+		records = append(records, record)
+	})
+	assert.NoError(t, err)
+	assert.Len(t, records, 4)
+
+	nums := make([]int64, len(records))
+	for i, record := range records {
+		nums[i] = record.Num
+	}
+	assert.Equal(t, []int64{1, 5, 8, 9}, nums)
+
+	summaryItems := records[2].GetSummary().GetUpdate()
+	assert.Len(t, summaryItems, 2)
+	assert.Equal(t, "loss", summaryItems[0].Key)
+	assert.Equal(t, "0.25", summaryItems[0].ValueJson)
+	assert.Equal(t, "acc", summaryItems[1].Key)
+
+	configRecord := records[3].GetConfig()
+	assert.Len(t, configRecord.GetUpdate(), 1)
+	assert.Equal(t, "a", configRecord.Update[0].Key)
+	assert.Equal(t, "3", configRecord.Update[0].ValueJson)
+	assert.Len(t, configRecord.GetRemove(), 1)
+	assert.Equal(t, "b", configRecord.Remove[0].Key)
+}
+
+// TestCompactStoreDottedSummaryKeys tests that compaction keeps a summary
+// key with a dot apart from the nested path it looks like.
+func TestCompactStoreDottedSummaryKeys(t *testing.T) {
+	dir := t.TempDir()
+	src := filepath.Join(dir, "run-test.wandb")
+	dst := filepath.Join(dir, "run-test.wandb.compact")
+	logger := observability.NewNoOpLogger()
+
+	store := server.NewStore(context.Background(), src, logger)
+	assert.NoError(t, store.Open(os.O_WRONLY))
+	for _, record := range []*service.Record{
+		{Num: 1, RecordType: &service.Record_Summary{Summary: &service.SummaryRecord{
+			Update: []*service.SummaryItem{{Key: "eval.acc", ValueJson: "1"}},
+		}}},
+		{Num: 2, RecordType: &service.Record_Summary{Summary: &service.SummaryRecord{
+			Update: []*service.SummaryItem{
+				{NestedKey: []string{"eval", "acc"}, ValueJson: "2"},
+			},
+		}}},
+	} {
+		assert.NoError(t, store.Write(record))
+	}
+	assert.NoError(t, store.Close())
+
+	_, err := server.CompactStore(context.Background(), logger, src, dst)
+	assert.NoError(t, err)
+
+	compacted := server.NewStore(context.Background(), dst, logger)
+	assert.NoError(t, compacted.Open(os.O_RDONLY))
+	defer compacted.Close()
+
+	var records []*service.Record
+	_, err = compacted.Replay(0, 0, func(record *service.Record) {
+		records = append(records, record)
+	})
+	assert.NoError(t, err)
+	assert.Len(t, records, 1)
+
+	items := records[0].GetSummary().GetUpdate()
+	assert.Len(t, items, 2)
+	assert.Equal(t, "eval.acc", items[0].Key)
+	assert.Equal(t, "1", items[0].ValueJson)
+	assert.Equal(t, []string{"eval", "acc"}, items[1].NestedKey)
+	assert.Equal(t, "2", items[1].ValueJson)
+}
+
+// TestCompressedStore tests that records written with compression are read
+// back by a store that learns the compression from the header.
+func TestCompressedStore(t *testing.T) {
+	for _, compression := range []server.Compression{
+		server.CompressionZstd,
+		server.CompressionSnappy,
+	} {
+		t.Run(compression.String(), func(t *testing.T) {
+			name := filepath.Join(t.TempDir(), "run-test.wandb")
+			logger := observability.NewNoOpLogger()
+
+			store := server.NewStore(context.Background(), name, logger,
+				server.WithStoreCompression(compression))
+			err := store.Open(os.O_WRONLY)
+			assert.NoError(t, err)
+
+			payload := string(make([]byte, 20000))
+			for i := 1; i <= 5; i++ {
+				err = store.Write(&service.Record{Num: int64(i), Uuid: payload})
+				assert.NoError(t, err)
+			}
+			// too small to compress
+			err = store.Write(&service.Record{Num: 6})
+			assert.NoError(t, err)
+			err = store.Close()
+			assert.NoError(t, err)
+
+			info, err := os.Stat(name)
+			assert.NoError(t, err)
+			assert.Less(t, info.Size(), int64(20000))
+
+			f, err := os.Open(name)
+			assert.NoError(t, err)
+			header := server.NewHeader()
+			assert.NoError(t, header.UnmarshalBinary(f))
+			assert.Equal(t, byte(1), header.Version)
+			assert.True(t, header.Valid())
+			f.Close()
+
+			store2 := server.NewStore(context.Background(), name, logger)
+			err = store2.Open(os.O_RDONLY)
+			assert.NoError(t, err)
+			defer store2.Close()
+
+			for i := 1; i <= 6; i++ {
+				record, err := store2.Read()
+				assert.NoError(t, err)
+				assert.Equal(t, int64(i), record.Num)
+			}
+			_, err = store2.Read()
+			assert.Equal(t, io.EOF, err)
+		})
+	}
+}
+
+func TestParseCompression(t *testing.T) {
+	compression, err := server.ParseCompression("zstd")
+	assert.NoError(t, err)
+	assert.Equal(t, server.CompressionZstd, compression)
+
+	compression, err = server.ParseCompression("")
+	assert.NoError(t, err)
+	assert.Equal(t, server.CompressionNone, compression)
+
+	_, err = server.ParseCompression("lz4")
+	assert.Error(t, err)
+}
+
+// TestScanAndRepairStore tests that a scan reports the corrupt part of a
+// log and that the repaired copy reads cleanly.
+func TestScanAndRepairStore(t *testing.T) {
+	dir := t.TempDir()
+	name := filepath.Join(dir, "run-test.wandb")
+	repaired := filepath.Join(dir, "run-test.repaired.wandb")
+	logger := observability.NewNoOpLogger()
+
+	store := server.NewStore(context.Background(), name, logger)
+	err := store.Open(os.O_WRONLY)
+	assert.NoError(t, err)
+
+	payload := string(make([]byte, 20000))
+	var offsets []int64
+	for i := 1; i <= 7; i++ {
+		record := &service.Record{Num: int64(i), Uuid: payload}
+		switch i {
+		case 1:
+			record.RecordType = &service.Record_Header{Header: &service.HeaderRecord{}}
+		case 2:
+			record.RecordType = &service.Record_Run{Run: &service.RunRecord{}}
+			record.Uuid = ""
+		case 7:
+			record.RecordType = &service.Record_Exit{Exit: &service.RunExitRecord{}}
+		}
+		err = store.Write(record)
+		assert.NoError(t, err)
+		offset, err := store.LastRecordOffset()
+		assert.NoError(t, err)
+		offsets = append(offsets, offset)
+	}
+	err = store.Close()
+	assert.NoError(t, err)
+
+	// break the first block, which holds the first three records
+	data, err := os.ReadFile(name)
+	assert.NoError(t, err)
+	data[100] ^= 0xff
+	err = os.WriteFile(name, data, 0644)
+	assert.NoError(t, err)
+
+	report, err := server.ScanStore(context.Background(), logger, name, nil)
+	assert.NoError(t, err)
+	assert.False(t, report.Clean())
+	assert.Equal(t, 4, report.Records)
+	assert.Equal(t, []server.OffsetRange{{Start: 0, End: offsets[3]}}, report.CorruptRanges)
+	assert.Equal(t, []server.NumRange{{First: 1, Last: 3}}, report.MissingRecords)
+	assert.Equal(t, int64(7), report.LastNum)
+	assert.False(t, report.HasHeader)
+	assert.False(t, report.HasRun)
+	assert.True(t, report.HasExit)
+
+	_, err = server.RepairStore(context.Background(), logger, name, repaired)
+	assert.NoError(t, err)
+
+	var nums []int64
+	report, err = server.ScanStore(context.Background(), logger, repaired,
+		func(record *service.Record) { nums = append(nums, record.Num) })
+	assert.NoError(t, err)
+	assert.Equal(t, []int64{4, 5, 6, 7}, nums)
+	assert.Zero(t, report.Errors)
+	assert.Empty(t, report.CorruptRanges)
+	assert.False(t, report.Truncated)
+	assert.True(t, report.Clean())
+}
+
+// TestScanCompactedStore tests that the gaps in the record numbers of a
+// compacted log are reported without making the log unclean.
+func TestScanCompactedStore(t *testing.T) {
+	dir := t.TempDir()
+	src := filepath.Join(dir, "run-test.wandb")
+	dst := filepath.Join(dir, "run-test.wandb.compact")
+	logger := observability.NewNoOpLogger()
+
+	summary := func(num int64, value string) *service.Record {
+		return &service.Record{Num: num, RecordType: &service.Record_Summary{
+			Summary: &service.SummaryRecord{
+				Update: []*service.SummaryItem{{Key: "loss", ValueJson: value}},
+			},
+		}}
+	}
+	store := server.NewStore(context.Background(), src, logger)
+	assert.NoError(t, store.Open(os.O_WRONLY))
+	for _, record := range []*service.Record{
+		{Num: 1, RecordType: &service.Record_Run{Run: &service.RunRecord{}}},
+		summary(2, "0.5"),
+		summary(3, "0.25"),
+		{Num: 4, RecordType: &service.Record_Exit{Exit: &service.RunExitRecord{}}},
+	} {
+		assert.NoError(t, store.Write(record))
+	}
+	assert.NoError(t, store.Close())
+	_, err := server.CompactStore(context.Background(), logger, src, dst)
+	assert.NoError(t, err)
+
+	report, err := server.ScanStore(context.Background(), logger, dst, nil)
+	assert.NoError(t, err)
+	assert.Equal(t, []server.NumRange{{First: 2, Last: 2}}, report.MissingRecords)
+	assert.True(t, report.Clean())
+}
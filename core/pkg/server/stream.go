@@ -10,17 +10,20 @@ import (
 	"sync"
 
 	"github.com/Khan/genqlient/graphql"
+	"github.com/wandb/wandb/core/internal/api"
 	"github.com/wandb/wandb/core/internal/filetransfer"
 	"github.com/wandb/wandb/core/internal/mailbox"
 	"github.com/wandb/wandb/core/internal/runfiles"
 	"github.com/wandb/wandb/core/internal/settings"
 	"github.com/wandb/wandb/core/internal/shared"
+	"github.com/wandb/wandb/core/internal/summarystore"
 	"github.com/wandb/wandb/core/internal/version"
 	"github.com/wandb/wandb/core/internal/watcher"
 	"github.com/wandb/wandb/core/pkg/filestream"
 	"github.com/wandb/wandb/core/pkg/monitor"
 	"github.com/wandb/wandb/core/pkg/observability"
 	"github.com/wandb/wandb/core/pkg/service"
+	"google.golang.org/protobuf/types/known/wrapperspb"
 )
 
 const (
@@ -71,6 +74,47 @@ type Stream struct {
 	dispatcher *Dispatcher
 }
 
+// streamDeps are components a Stream can share with other streams.
+type streamDeps struct {
+	// logger replaces the stream's own logger
+	logger *observability.CoreLogger
+
+	// backend replaces the backend created from the stream's settings
+	backend *api.Backend
+
+	// fileTransferManager is a manager shared with other streams
+	fileTransferManager filetransfer.FileTransferManager
+}
+
+type StreamOption func(*streamDeps)
+
+// WithStreamLogger makes the stream log to the given logger instead of
+// the internal log file in its settings.
+func WithStreamLogger(logger *observability.CoreLogger) StreamOption {
+	return func(d *streamDeps) {
+		d.logger = logger
+	}
+}
+
+// WithStreamBackend makes the stream use a backend shared with other
+// streams.
+func WithStreamBackend(backend *api.Backend) StreamOption {
+	return func(d *streamDeps) {
+		d.backend = backend
+	}
+}
+
+// WithStreamFileTransferManager makes the stream upload files through a
+// manager shared with other streams.
+//
+// The stream neither starts nor closes the shared manager, but waits for
+// its own uploads before finishing and keeps its own upload statistics.
+func WithStreamFileTransferManager(manager filetransfer.FileTransferManager) StreamOption {
+	return func(d *streamDeps) {
+		d.fileTransferManager = manager
+	}
+}
+
 func streamLogger(settings *settings.Settings) *observability.CoreLogger {
 	// TODO: when we add session concept re-do this to use user provided path
 	targetPath := filepath.Join(settings.GetLogDir(), "debug-core.log")
@@ -126,12 +170,27 @@ func streamLogger(settings *settings.Settings) *observability.CoreLogger {
 }
 
 // NewStream creates a new stream with the given settings and responders.
-func NewStream(ctx context.Context, settings *settings.Settings, _ string) *Stream {
+func NewStream(
+	ctx context.Context,
+	settings *settings.Settings,
+	_ string,
+	opts ...StreamOption,
+) *Stream {
+	deps := &streamDeps{}
+	for _, opt := range opts {
+		opt(deps)
+	}
+
+	logger := deps.logger
+	if logger == nil {
+		logger = streamLogger(settings)
+	}
+
 	ctx, cancel := context.WithCancel(ctx)
 	s := &Stream{
 		ctx:          ctx,
 		cancel:       cancel,
-		logger:       streamLogger(settings),
+		logger:       logger,
 		wg:           sync.WaitGroup{},
 		settings:     settings,
 		inChan:       make(chan *service.Record, BufferSize),
@@ -147,8 +206,12 @@ func NewStream(ctx context.Context, settings *settings.Settings, _ string) *Stre
 	// TODO: replace this with a logger that can be read by the user
 	peeker := observability.NewPeeker()
 
-	backendOrNil := NewBackend(s.logger, settings)
+	backendOrNil := deps.backend
+	if backendOrNil == nil {
+		backendOrNil = NewBackend(s.logger, settings)
+	}
 	fileTransferStats := filetransfer.NewFileTransferStats()
+	uploadErrors := &uploadErrors{}
 	var graphqlClientOrNil graphql.Client
 	var fileStreamOrNil filestream.FileStream
 	var fileTransferManagerOrNil filetransfer.FileTransferManager
@@ -156,11 +219,18 @@ func NewStream(ctx context.Context, settings *settings.Settings, _ string) *Stre
 	if backendOrNil != nil {
 		graphqlClientOrNil = NewGraphQLClient(backendOrNil, settings, peeker)
 		fileStreamOrNil = NewFileStream(backendOrNil, s.logger, settings, peeker)
-		fileTransferManagerOrNil = NewFileTransferManager(
-			fileTransferStats,
-			s.logger,
-			settings,
-		)
+		if deps.fileTransferManager != nil {
+			fileTransferManagerOrNil = filetransfer.NewSharedFileTransferManager(
+				deps.fileTransferManager,
+				fileTransferStats,
+			)
+		} else {
+			fileTransferManagerOrNil = NewFileTransferManager(
+				fileTransferStats,
+				s.logger,
+				settings,
+			)
+		}
 		runfilesUploaderOrNil = NewRunfilesUploader(
 			s.ctx,
 			s.logger,
@@ -168,11 +238,24 @@ func NewStream(ctx context.Context, settings *settings.Settings, _ string) *Stre
 			fileStreamOrNil,
 			fileTransferManagerOrNil,
 			graphqlClientOrNil,
+			uploadErrors.add,
 		)
 	}
 
 	mailbox := mailbox.NewMailbox()
 
+	// only runs that are uploaded as they are written can be resumed from
+	// a checkpoint, offline runs are uploaded with sync
+	var checkpointOrNil *Checkpoint
+	var resendFrom *CheckpointState
+	if backendOrNil != nil && !s.settings.Proto.GetXSync().GetValue() {
+		checkpointOrNil = NewCheckpoint(
+			CheckpointPath(s.settings.Proto.GetSyncFile().GetValue()),
+		)
+	} else if backendOrNil != nil {
+		resendFrom = s.unfinishedCheckpoint()
+	}
+
 	s.handler = NewHandler(s.ctx, s.logger,
 		WithHandlerSettings(s.settings.Proto),
 		WithHandlerFwdChannel(make(chan *service.Record, BufferSize)),
@@ -181,14 +264,22 @@ func NewStream(ctx context.Context, settings *settings.Settings, _ string) *Stre
 		WithHandlerRunfilesUploader(runfilesUploaderOrNil),
 		WithHandlerTBHandler(NewTBHandler(w, s.logger, s.settings.Proto, s.loopBackChan)),
 		WithHandlerFileTransferStats(fileTransferStats),
-		WithHandlerSummaryHandler(NewSummaryHandler(s.logger)),
+		WithHandlerSummaryHandler(NewSummaryHandler(s.logger, summarystore.Params{
+			Dir: s.settings.Proto.GetSyncDir().GetValue(),
+		})),
 		WithHandlerMetricHandler(NewMetricHandler()),
 		WithHandlerMailbox(mailbox),
+		WithHandlerHistoryMerge(sharedHistoryMerge(s.logger, s.settings)),
 	)
 
 	s.writer = NewWriter(s.ctx, s.logger,
 		WithWriterSettings(s.settings.Proto),
 		WithWriterFwdChannel(make(chan *service.Record, BufferSize)),
+		WithWriterCheckpoint(checkpointOrNil),
+		WithWriterStoreOptions(syncFileStoreOptions(s.logger, s.settings)...),
+		// compaction changes record offsets, which a checkpoint refers to
+		WithWriterCompaction(checkpointOrNil == nil &&
+			s.settings.Proto.GetXSyncFileCompact().GetValue()),
 	)
 
 	s.sender = NewSender(
@@ -205,6 +296,9 @@ func NewStream(ctx context.Context, settings *settings.Settings, _ string) *Stre
 		WithSenderFwdChannel(s.loopBackChan),
 		WithSenderOutChannel(make(chan *service.Result, BufferSize)),
 		WithSenderMailbox(mailbox),
+		WithSenderCheckpoint(checkpointOrNil),
+		WithSenderResendFrom(resendFrom),
+		WithSenderUploadErrors(uploadErrors),
 	)
 
 	s.dispatcher = NewDispatcher(s.logger)
@@ -282,6 +376,38 @@ func (s *Stream) Start() {
 	s.logger.Debug("starting stream", "id", s.settings.GetRunID())
 }
 
+// unfinishedCheckpoint returns the checkpoint of the transaction log being
+// synced if the process that wrote it stopped before uploading all of it,
+// or nil.
+//
+// Syncing such a log only sends the records after the checkpoint, and the
+// run is resumed on the server so that its history continues where the
+// previous upload stopped.
+func (s *Stream) unfinishedCheckpoint() *CheckpointState {
+	syncFile := s.settings.Proto.GetSyncFile().GetValue()
+	state, err := LoadCheckpoint(CheckpointPath(syncFile))
+	if err != nil {
+		if !os.IsNotExist(err) {
+			s.logger.CaptureError("stream: failed to load checkpoint", err)
+		}
+		return nil
+	}
+	if state.Finished {
+		return nil
+	}
+
+	if s.settings.Proto.GetResume().GetValue() == "" {
+		s.settings.Proto.Resume = &wrapperspb.StringValue{Value: "allow"}
+	}
+	s.logger.Info(
+		"stream: resending unfinished run",
+		"sync_file", syncFile,
+		"num", state.Num,
+		"offset", state.Offset,
+	)
+	return state
+}
+
 // HandleRecord handles the given record by sending it to the stream's handler.
 func (s *Stream) HandleRecord(rec *service.Record) {
 	s.logger.Debug("handling record", "record", rec)
@@ -71,32 +71,100 @@ func NewFileStream(
 	backend *api.Backend,
 	logger *observability.CoreLogger,
 	settings *settings.Settings,
-	peeker api.Peeker,
+	peeker *observability.Peeker,
 ) filestream.FileStream {
 	fileStreamHeaders := map[string]string{}
 	if settings.Proto.GetXShared().GetValue() {
 		fileStreamHeaders["X-WANDB-USE-ASYNC-FILESTREAM"] = "true"
+		fileStreamHeaders[filestream.HistoryMergeHeader] = string(sharedHistoryMerge(logger, settings))
+	}
+
+	// a nil *Peeker must not end up in a non-nil interface
+	var networkPeeker api.Peeker
+	if peeker != nil {
+		networkPeeker = peeker
 	}
 
 	fileStreamRetryClient := backend.NewClient(api.ClientOptions{
+		RetryPolicy:     clients.FileStreamRetryPolicy,
 		RetryMax:        int(settings.Proto.GetXFileStreamRetryMax().GetValue()),
 		RetryWaitMin:    clients.SecondsToDuration(settings.Proto.GetXFileStreamRetryWaitMinSeconds().GetValue()),
 		RetryWaitMax:    clients.SecondsToDuration(settings.Proto.GetXFileStreamRetryWaitMaxSeconds().GetValue()),
 		NonRetryTimeout: clients.SecondsToDuration(settings.Proto.GetXFileStreamTimeoutSeconds().GetValue()),
 		ExtraHeaders:    fileStreamHeaders,
-		NetworkPeeker:   peeker,
+		NetworkPeeker:   networkPeeker,
+		// the filestream limits its rate itself
+		DisableRateLimit: true,
 	})
 
 	params := filestream.FileStreamParams{
-		Settings:  settings.Proto,
-		Logger:    logger,
-		ApiClient: fileStreamRetryClient,
-		ClientId:  shared.ShortID(32),
+		Settings:      settings.Proto,
+		Logger:        logger,
+		ApiClient:     fileStreamRetryClient,
+		ClientId:      shared.ShortID(32),
+		SpillDir:      settings.Proto.GetSyncDir().GetValue(),
+		NetworkStatus: peeker,
+		GzipRequests:  settings.Proto.GetXFileStreamGzip().GetValue(),
+		Sinks:         fileStreamSinks(logger, settings),
 	}
 
 	return filestream.NewFileStream(params)
 }
 
+// sharedHistoryMerge returns how the history of the writers of a shared run
+// is merged, as set by the _shared_history_merge setting to one of
+// "server_step" (the default), "step" or "timestamp".
+func sharedHistoryMerge(
+	logger *observability.CoreLogger,
+	settings *settings.Settings,
+) filestream.HistoryMerge {
+	merge, err := filestream.ParseHistoryMerge(
+		settings.Proto.GetXSharedHistoryMerge().GetValue(),
+	)
+	if err != nil {
+		logger.CaptureWarn("stream: using the default history merge", "error", err)
+		return filestream.HistoryMergeServerStep
+	}
+	return merge
+}
+
+// fileStreamSinks returns the destinations to mirror the filestream to.
+//
+// The _file_stream_mirror_dir setting keeps a copy of the streamed files in
+// a directory, and _file_stream_mirror_url sends them to another server
+// using the API key in _file_stream_mirror_api_key.
+func fileStreamSinks(
+	logger *observability.CoreLogger,
+	settings *settings.Settings,
+) []filestream.Sink {
+	var sinks []filestream.Sink
+
+	if dir := settings.Proto.GetXFileStreamMirrorDir().GetValue(); dir != "" {
+		sinks = append(sinks, filestream.NewFileSink(dir))
+	}
+
+	if mirrorURL := settings.Proto.GetXFileStreamMirrorUrl().GetValue(); mirrorURL != "" {
+		baseURL, err := url.Parse(mirrorURL)
+		if err != nil {
+			logger.CaptureError("sender: failed to parse filestream mirror URL", err)
+			return sinks
+		}
+		backend := api.New(api.BackendOptions{
+			BaseURL: baseURL,
+			Logger:  logger.Logger,
+			APIKey:  settings.Proto.GetXFileStreamMirrorApiKey().GetValue(),
+		})
+		sinks = append(sinks, filestream.NewHTTPSink(backend.NewClient(api.ClientOptions{
+			RetryMax:        int(settings.Proto.GetXFileStreamRetryMax().GetValue()),
+			RetryWaitMin:    clients.SecondsToDuration(settings.Proto.GetXFileStreamRetryWaitMinSeconds().GetValue()),
+			RetryWaitMax:    clients.SecondsToDuration(settings.Proto.GetXFileStreamRetryWaitMaxSeconds().GetValue()),
+			NonRetryTimeout: clients.SecondsToDuration(settings.Proto.GetXFileStreamTimeoutSeconds().GetValue()),
+		})))
+	}
+
+	return sinks
+}
+
 func NewFileTransferManager(
 	fileTransferStats filetransfer.FileTransferStats,
 	logger *observability.CoreLogger,
@@ -131,6 +199,7 @@ func NewRunfilesUploader(
 	fileStream filestream.FileStream,
 	fileTransfer filetransfer.FileTransferManager,
 	graphQL graphql.Client,
+	onError func(err error),
 ) runfiles.Uploader {
 	return runfiles.NewUploader(runfiles.UploaderParams{
 		Ctx:          ctx,
@@ -141,5 +210,30 @@ func NewRunfilesUploader(
 		GraphQL:      graphQL,
 		FileWatcher:  watcher2.New(watcher2.Params{Logger: logger}),
 		BatchWindow:  50 * time.Millisecond,
+		OnError:      onError,
 	})
 }
+
+// syncFileStoreOptions returns the options for the run's transaction log.
+//
+// The _sync_file_segment_mb setting splits the log into segments of about
+// that many megabytes, and _sync_file_compression ("zstd" or "snappy")
+// compresses its records.
+func syncFileStoreOptions(
+	logger *observability.CoreLogger,
+	settings *settings.Settings,
+) []StoreOption {
+	var opts []StoreOption
+	if mb := settings.Proto.GetXSyncFileSegmentMb().GetValue(); mb > 0 {
+		opts = append(opts, WithStoreSegmentSize(int64(mb)<<20))
+	}
+
+	compression, err := ParseCompression(
+		settings.Proto.GetXSyncFileCompression().GetValue())
+	if err != nil {
+		logger.CaptureError("stream: not compressing the transaction log", err)
+	} else if compression != CompressionNone {
+		opts = append(opts, WithStoreCompression(compression))
+	}
+	return opts
+}
@@ -1,27 +1,61 @@
 package server
 
 import (
+	"bufio"
+	"math"
+	"os"
+	"strconv"
+	"strings"
+
+	"github.com/segmentio/encoding/json"
+	"github.com/wandb/simplejsonext"
+
 	"github.com/wandb/wandb/core/internal/debounce"
+	"github.com/wandb/wandb/core/internal/runconfig"
+	"github.com/wandb/wandb/core/internal/summarystore"
 	"github.com/wandb/wandb/core/pkg/observability"
 	"github.com/wandb/wandb/core/pkg/service"
 )
 
 type SummaryHandler struct {
-	// consolidatedSummary is the full summary (all keys)
-	// TODO(memory): persist this in the future as it will grow with number of distinct keys
-	consolidatedSummary map[string]string
+	logger *observability.CoreLogger
+
+	// consolidatedSummary is the full summary (all keys), whose values are
+	// spilled to disk when there are too many to keep in memory
+	consolidatedSummary *summarystore.Store
+
+	// storeFailed is whether the summary store has failed, which is only
+	// reported the first time
+	storeFailed bool
+
+	// latest is the latest value of each key whose summary is not its
+	// latest value, such as the keys of metrics with summary options
+	latest map[string]string
+
+	// aggregates are the aggregates of the metrics with summary options
+	aggregates map[string]*metricAggregates
 
 	// summaryDelta is the delta summary (keys updated since the last time we sent summary)
 	summaryDelta map[string]string
 
+	// summaryRemoved is the keys removed since the last time we sent summary
+	summaryRemoved map[string]struct{}
+
 	// summaryDebouncer is the debouncer for summary updates
 	summaryDebouncer *debounce.Debouncer
 }
 
-func NewSummaryHandler(logger *observability.CoreLogger) *SummaryHandler {
+func NewSummaryHandler(
+	logger *observability.CoreLogger,
+	storeParams summarystore.Params,
+) *SummaryHandler {
 	return &SummaryHandler{
-		consolidatedSummary: make(map[string]string),
+		logger:              logger,
+		consolidatedSummary: summarystore.New(storeParams),
 		summaryDelta:        make(map[string]string),
+		summaryRemoved:      make(map[string]struct{}),
+		latest:              make(map[string]string),
+		aggregates:          make(map[string]*metricAggregates),
 		summaryDebouncer: debounce.NewDebouncer(
 			summaryDebouncerRateLimit,
 			summaryDebouncerBurstSize,
@@ -44,6 +78,441 @@ func (sh *SummaryHandler) Flush(f func()) {
 func (sh *SummaryHandler) updateSummaryDelta(summaryRecord *service.Record) {
 	for _, item := range summaryRecord.GetSummary().GetUpdate() {
 		sh.summaryDelta[item.GetKey()] = item.GetValueJson()
+		delete(sh.summaryRemoved, item.GetKey())
+	}
+	for _, item := range summaryRecord.GetSummary().GetRemove() {
+		delete(sh.summaryDelta, item.GetKey())
+		sh.summaryRemoved[item.GetKey()] = struct{}{}
 	}
 	sh.summaryDebouncer.SetNeedsDebounce()
 }
+
+// Close removes the files of the summary store.
+func (sh *SummaryHandler) Close() {
+	if err := sh.consolidatedSummary.Close(); err != nil {
+		sh.logger.CaptureError("summary: failed to close store", err)
+	}
+}
+
+// updateSummary applies the updates and removals of a summary record, and
+// returns the summary record of the changed top-level keys.
+//
+// An item with a nested key changes the summary at that path, the same way
+// as the run's config: the maps above the path are created or kept, with
+// the other values in them. An item with a plain key replaces its value.
+func (sh *SummaryHandler) updateSummary(summary *service.SummaryRecord) *service.Record {
+	changes := &summaryChanges{
+		sh:     sh,
+		state:  make(map[string]keyChange),
+		values: make(map[string]string),
+		tree:   runconfig.New(),
+	}
+
+	for _, item := range summary.GetUpdate() {
+		path := summaryKeyPath(item)
+		if len(path) == 1 {
+			changes.set(path[0], item.GetValueJson())
+			continue
+		}
+
+		value, err := simplejsonext.UnmarshalString(item.GetValueJson())
+		if err == nil {
+			err = changes.parse(path[0])
+		}
+		if err == nil {
+			err = changes.tree.UpdateAtPath(path, value)
+		}
+		if err != nil {
+			sh.logger.CaptureError("summary: failed to update nested key", err, "key", path)
+		}
+	}
+
+	for _, item := range summary.GetRemove() {
+		path := summaryKeyPath(item)
+		if len(path) == 1 {
+			changes.remove(path[0])
+			continue
+		}
+
+		if err := changes.parse(path[0]); err != nil {
+			sh.logger.CaptureError("summary: failed to remove nested key", err, "key", path)
+			continue
+		}
+		changes.tree.RemoveAtPath(path)
+	}
+
+	return changes.apply()
+}
+
+// UpdateSummary applies the updates and removals of a summary record to
+// the summary, for readers of a transaction log that keep its summary.
+func (sh *SummaryHandler) UpdateSummary(summary *service.SummaryRecord) {
+	sh.updateSummary(summary)
+}
+
+// summaryChanges are the changes of a summary record to the top-level keys
+// of the summary, so that each key is read and stored once.
+type summaryChanges struct {
+	sh *SummaryHandler
+
+	// keys are the keys changed, in order, and state is how each changed
+	keys  []string
+	state map[string]keyChange
+
+	// values are the values of the keys set as a whole
+	values map[string]string
+
+	// tree has the values of the keys changed at nested paths
+	tree *runconfig.RunConfig
+}
+
+// keyChange is how a top-level key of the summary changed.
+type keyChange int
+
+const (
+	keySet keyChange = iota
+	keyInTree
+	keyRemoved
+)
+
+func (c *summaryChanges) mark(key string, change keyChange) {
+	if _, ok := c.state[key]; !ok {
+		c.keys = append(c.keys, key)
+	}
+	c.state[key] = change
+}
+
+// set sets the value of a key.
+func (c *summaryChanges) set(key, value string) {
+	c.tree.RemoveAtPath(runconfig.RunConfigPath{key})
+	c.values[key] = value
+	c.mark(key, keySet)
+}
+
+// remove removes a key.
+func (c *summaryChanges) remove(key string) {
+	c.tree.RemoveAtPath(runconfig.RunConfigPath{key})
+	delete(c.values, key)
+	c.mark(key, keyRemoved)
+}
+
+// parse puts the value of a key into the tree, to change it at nested
+// paths.
+func (c *summaryChanges) parse(key string) error {
+	var value string
+	var ok bool
+	change, changed := c.state[key]
+	switch {
+	case !changed:
+		value, ok = c.sh.get(key)
+	case change == keySet:
+		value, ok = c.values[key], true
+	case change == keyInTree:
+		return nil
+	}
+
+	if ok {
+		parsed, err := simplejsonext.UnmarshalString(value)
+		if err != nil {
+			return err
+		}
+		if err := c.tree.UpdateAtPath(runconfig.RunConfigPath{key}, parsed); err != nil {
+			return err
+		}
+	}
+	delete(c.values, key)
+	c.mark(key, keyInTree)
+	return nil
+}
+
+// apply stores the changes and returns the summary record of them.
+func (c *summaryChanges) apply() *service.Record {
+	summary := &service.SummaryRecord{}
+	for _, key := range c.keys {
+		value, ok := c.values[key]
+		if c.state[key] == keyInTree {
+			var node interface{}
+			if node, ok = c.tree.Tree()[key]; ok {
+				var err error
+				if value, err = simplejsonext.MarshalToString(node); err != nil {
+					c.sh.logger.CaptureError("summary: failed to marshal value", err, "key", key)
+					continue
+				}
+			}
+		}
+
+		if ok {
+			c.sh.set(key, value)
+			summary.Update = append(summary.Update, &service.SummaryItem{Key: key, ValueJson: value})
+			continue
+		}
+
+		// only keys that were in the summary are reported as removed
+		n := c.sh.consolidatedSummary.Len()
+		c.sh.consolidatedSummary.Delete(key)
+		if c.sh.consolidatedSummary.Len() < n {
+			summary.Remove = append(summary.Remove, &service.SummaryItem{Key: key})
+		}
+	}
+
+	return &service.Record{
+		RecordType: &service.Record_Summary{Summary: summary},
+	}
+}
+
+// summaryKeyPath returns the path in the summary of an item.
+func summaryKeyPath(item *service.SummaryItem) runconfig.RunConfigPath {
+	if len(item.GetNestedKey()) > 0 {
+		return runconfig.RunConfigPath(item.GetNestedKey())
+	}
+	return runconfig.RunConfigPath{item.GetKey()}
+}
+
+// Items returns all items of the summary.
+func (sh *SummaryHandler) Items() []*service.SummaryItem {
+	var items []*service.SummaryItem
+	err := sh.consolidatedSummary.Range(func(key, value string) error {
+		items = append(items, &service.SummaryItem{Key: key, ValueJson: value})
+		return nil
+	})
+	sh.checkStore(err)
+	return items
+}
+
+// writeFile writes the summary as a JSON object to a file.
+//
+// Values are written as the JSON they hold rather than as strings of it, so
+// that the file reads like the summary of the run.
+//
+// Items are written one at a time, so that the summary is never all in
+// memory.
+func (sh *SummaryHandler) writeFile(path string) error {
+	file, err := os.OpenFile(path, os.O_WRONLY|os.O_CREATE|os.O_TRUNC, 0644)
+	if err != nil {
+		return err
+	}
+	w := bufio.NewWriter(file)
+
+	_, _ = w.WriteString("{")
+	first := true
+	err = sh.consolidatedSummary.Range(func(key, value string) error {
+		if !first {
+			_, _ = w.WriteString(",")
+		}
+		first = false
+
+		keyJson, err := json.Marshal(key)
+		if err != nil {
+			return err
+		}
+		_, _ = w.WriteString("\n  ")
+		_, _ = w.Write(keyJson)
+		_, _ = w.WriteString(": ")
+		_, err = w.WriteString(value)
+		return err
+	})
+	if err == nil {
+		if !first {
+			_, _ = w.WriteString("\n")
+		}
+		_, _ = w.WriteString("}")
+		err = w.Flush()
+	}
+
+	if closeErr := file.Close(); err == nil {
+		err = closeErr
+	}
+	return err
+}
+
+// get returns the summary of a key.
+func (sh *SummaryHandler) get(key string) (string, bool) {
+	value, ok, err := sh.consolidatedSummary.Get(key)
+	sh.checkStore(err)
+	return value, ok
+}
+
+// set sets the summary of a key.
+func (sh *SummaryHandler) set(key, value string) {
+	sh.checkStore(sh.consolidatedSummary.Set(key, value))
+}
+
+// checkStore reports an error of the summary store.
+//
+// Values that fail to spill stay in memory, so the summary is still whole.
+func (sh *SummaryHandler) checkStore(err error) {
+	if err == nil {
+		return
+	}
+	if sh.storeFailed {
+		sh.logger.Debug("summary: store failed", "error", err)
+		return
+	}
+	sh.storeFailed = true
+	sh.logger.CaptureError("summary: store failed", err)
+}
+
+// updateFromHistory adds the values of a history row to the summary and
+// returns the summary record of the changes.
+//
+// The summary of a key is its latest value, unless the key's metric has
+// summary options. Then it is an object with the aggregates the metric asks
+// for, such as {"max": 0.9, "min": 0.1} for loss, which is shown as loss.max
+// and loss.min. A metric with the "none" option is left out of the summary.
+func (sh *SummaryHandler) updateFromHistory(
+	items []*service.HistoryItem,
+	metrics map[string]*service.MetricRecord,
+) *service.Record {
+	var updates []*service.SummaryItem
+
+	for _, item := range items {
+		key := item.GetKey()
+		value := item.GetValueJson()
+		metric := metrics[key]
+
+		if !hasSummaryAggregates(metric.GetSummary()) {
+			if metric.GetSummary().GetNone() {
+				sh.latest[key] = value
+				continue
+			}
+			sh.set(key, value)
+			updates = append(updates, &service.SummaryItem{Key: key, ValueJson: value})
+			continue
+		}
+
+		sh.latest[key] = value
+		aggregates, ok := sh.aggregates[key]
+		if !ok {
+			aggregates = &metricAggregates{}
+			sh.aggregates[key] = aggregates
+		}
+		if !aggregates.add(value) {
+			continue
+		}
+		summary := aggregates.summary(metric.GetSummary(), metric.GetGoal())
+		sh.set(key, summary)
+		updates = append(updates, &service.SummaryItem{Key: key, ValueJson: summary})
+	}
+
+	return &service.Record{
+		RecordType: &service.Record_Summary{
+			Summary: &service.SummaryRecord{Update: updates},
+		},
+	}
+}
+
+// latestValue returns the latest value of a key, whether or not it is the
+// key's summary.
+func (sh *SummaryHandler) latestValue(key string) (string, bool) {
+	if value, ok := sh.latest[key]; ok {
+		return value, true
+	}
+	return sh.get(key)
+}
+
+// hasSummaryAggregates returns whether summary options ask for any
+// aggregate of a metric, rather than its latest value or nothing.
+func hasSummaryAggregates(options *service.MetricSummary) bool {
+	return !options.GetNone() && (options.GetMin() ||
+		options.GetMax() ||
+		options.GetMean() ||
+		options.GetBest() ||
+		options.GetFirst() ||
+		options.GetLast())
+}
+
+// metricAggregates are the aggregates of the values of a metric.
+//
+// The first, last, minimum and maximum values are kept as the JSON they were
+// logged as, so that integers stay integers. Values that are not numbers, or
+// are NaN, are not aggregated.
+type metricAggregates struct {
+	first, last string
+
+	min, max           string
+	minValue, maxValue float64
+
+	total float64
+	count int
+}
+
+// add adds a value to the aggregates, and returns whether it is a number.
+func (a *metricAggregates) add(value string) bool {
+	v, err := strconv.ParseFloat(value, 64)
+	if err != nil || math.IsNaN(v) {
+		return false
+	}
+
+	if a.count == 0 {
+		a.first = value
+	}
+	if a.count == 0 || v < a.minValue {
+		a.min, a.minValue = value, v
+	}
+	if a.count == 0 || v > a.maxValue {
+		a.max, a.maxValue = value, v
+	}
+	a.last = value
+	a.total += v
+	a.count++
+	return true
+}
+
+// summary returns the JSON object of the aggregates that the summary
+// options ask for.
+//
+// The best value is the maximum if the goal is to maximize the metric, and
+// the minimum otherwise.
+func (a *metricAggregates) summary(
+	options *service.MetricSummary,
+	goal service.MetricRecord_MetricGoal,
+) string {
+	best := a.min
+	if goal == service.MetricRecord_GOAL_MAXIMIZE {
+		best = a.max
+	}
+
+	fields := []struct {
+		name  string
+		value string
+		ok    bool
+	}{
+		{"best", best, options.GetBest()},
+		{"first", a.first, options.GetFirst()},
+		{"last", a.last, options.GetLast()},
+		{"max", a.max, options.GetMax()},
+		{"mean", formatSummaryFloat(a.total / float64(a.count)), options.GetMean()},
+		{"min", a.min, options.GetMin()},
+	}
+
+	var b strings.Builder
+	b.WriteByte('{')
+	for _, field := range fields {
+		if !field.ok {
+			continue
+		}
+		if b.Len() > 1 {
+			b.WriteString(", ")
+		}
+		b.WriteString(strconv.Quote(field.name))
+		b.WriteString(": ")
+		b.WriteString(field.value)
+	}
+	b.WriteByte('}')
+	return b.String()
+}
+
+// formatSummaryFloat formats a float as JSON, writing infinities and NaN
+// the way the Python client does.
+func formatSummaryFloat(v float64) string {
+	switch {
+	case math.IsNaN(v):
+		return "NaN"
+	case math.IsInf(v, 1):
+		return "Infinity"
+	case math.IsInf(v, -1):
+		return "-Infinity"
+	default:
+		return strconv.FormatFloat(v, 'g', -1, 64)
+	}
+}
@@ -0,0 +1,176 @@
+package server_test
+
+import (
+	"context"
+	"fmt"
+	"os"
+	"path/filepath"
+	"strings"
+	"testing"
+
+	"github.com/segmentio/encoding/json"
+	"github.com/stretchr/testify/assert"
+	"github.com/stretchr/testify/require"
+	"google.golang.org/protobuf/types/known/wrapperspb"
+
+	"github.com/wandb/wandb/core/internal/summarystore"
+	"github.com/wandb/wandb/core/pkg/observability"
+	"github.com/wandb/wandb/core/pkg/server"
+	"github.com/wandb/wandb/core/pkg/service"
+)
+
+// makeSummaryHandler starts a handler that keeps the summary, and returns
+// its input, forwarding and output channels, and a channel closed when the
+// handler is done.
+func makeSummaryHandler(
+	filesDir string,
+	storeParams summarystore.Params,
+) (
+	chan *service.Record,
+	chan *service.Record,
+	chan *service.Result,
+	chan struct{},
+) {
+	inChan, _ := makeInboundChannels()
+	fwdChan, outChan := makeOutboundChannels()
+	h := server.NewHandler(context.Background(),
+		observability.NewNoOpLogger(),
+		server.WithHandlerSettings(&service.Settings{
+			FilesDir: &wrapperspb.StringValue{Value: filesDir},
+		}),
+		server.WithHandlerFwdChannel(fwdChan),
+		server.WithHandlerOutChannel(outChan),
+		server.WithHandlerSummaryHandler(server.NewSummaryHandler(
+			observability.NewNoOpLogger(),
+			storeParams,
+		)),
+	)
+	done := make(chan struct{})
+	go func() {
+		h.Do(inChan)
+		close(done)
+	}()
+	return inChan, fwdChan, outChan, done
+}
+
+func makeSummaryRecord(summary *service.SummaryRecord) *service.Record {
+	return &service.Record{RecordType: &service.Record_Summary{Summary: summary}}
+}
+
+func makeFlushSummaryRecord() *service.Record {
+	return &service.Record{RecordType: &service.Record_Request{
+		Request: &service.Request{RequestType: &service.Request_Defer{
+			Defer: &service.DeferRequest{State: service.DeferRequest_FLUSH_SUM},
+		}},
+	}}
+}
+
+func TestSummaryFileWithSpilledValues(t *testing.T) {
+	filesDir := t.TempDir()
+	spillDir := t.TempDir()
+	inChan, fwdChan, outChan, done := makeSummaryHandler(filesDir,
+		summarystore.Params{Dir: spillDir, MaxHotBytes: 64})
+	go func() {
+		for range fwdChan {
+		}
+	}()
+
+	var items []*service.SummaryItem
+	for i := 0; i < 50; i++ {
+		items = append(items, &service.SummaryItem{
+			Key:       fmt.Sprintf("eval/sample_%02d", i),
+			ValueJson: fmt.Sprintf(`{"score": %d}`, i),
+		})
+	}
+	inChan <- makeSummaryRecord(&service.SummaryRecord{Update: items})
+	inChan <- makeFlushSummaryRecord()
+	response := getSummary(inChan, outChan)
+	// the summary has the runtime too
+	assert.Len(t, response, 51)
+
+	spilled, err := filepath.Glob(filepath.Join(spillDir, "*"))
+	require.NoError(t, err)
+	assert.NotEmpty(t, spilled)
+
+	data, err := os.ReadFile(filepath.Join(filesDir, server.SummaryFileName))
+	require.NoError(t, err)
+	assert.True(t, strings.HasPrefix(string(data), "{\n  \"_wandb\": "))
+	var summary map[string]any
+	require.NoError(t, json.Unmarshal(data, &summary))
+	assert.Len(t, summary, 51)
+	assert.Equal(t, map[string]any{"score": 42.0}, summary["eval/sample_42"])
+
+	close(inChan)
+	<-done
+	spilled, err = filepath.Glob(filepath.Join(spillDir, "*"))
+	require.NoError(t, err)
+	assert.Empty(t, spilled)
+}
+
+func TestSummaryFileHasJSONValues(t *testing.T) {
+	filesDir := t.TempDir()
+	inChan, fwdChan, outChan, done := makeSummaryHandler(filesDir, summarystore.Params{})
+	go func() {
+		for range fwdChan {
+		}
+	}()
+
+	inChan <- makeSummaryRecord(&service.SummaryRecord{Update: []*service.SummaryItem{
+		{Key: "acc", ValueJson: "0.5"},
+		{Key: "name", ValueJson: `"best"`},
+		{Key: "eval", ValueJson: `{"f1": [1, 2]}`},
+	}})
+	inChan <- makeFlushSummaryRecord()
+	_ = getSummary(inChan, outChan)
+
+	data, err := os.ReadFile(filepath.Join(filesDir, server.SummaryFileName))
+	require.NoError(t, err)
+	var summary map[string]any
+	require.NoError(t, json.Unmarshal(data, &summary))
+	assert.Equal(t, 0.5, summary["acc"])
+	assert.Equal(t, "best", summary["name"])
+	assert.Equal(t, map[string]any{"f1": []any{1.0, 2.0}}, summary["eval"])
+
+	close(inChan)
+	<-done
+}
+
+func TestNestedSummaryKeys(t *testing.T) {
+	inChan, fwdChan, outChan, _ := makeSummaryHandler(t.TempDir(), summarystore.Params{})
+
+	inChan <- makeSummaryRecord(&service.SummaryRecord{Update: []*service.SummaryItem{
+		{Key: "eval", ValueJson: `{"metrics": {"accuracy": 0.5, "f1": 0.4}, "loss": NaN}`},
+		{Key: "a", ValueJson: "1"},
+		{Key: "b", ValueJson: "2"},
+	}})
+	inChan <- makeSummaryRecord(&service.SummaryRecord{
+		Update: []*service.SummaryItem{
+			{NestedKey: []string{"eval", "metrics", "accuracy"}, ValueJson: "0.9"},
+			{NestedKey: []string{"eval", "new", "x"}, ValueJson: "1"},
+			// b is not a map, so this is skipped
+			{NestedKey: []string{"b", "c"}, ValueJson: "3"},
+		},
+		Remove: []*service.SummaryItem{
+			{NestedKey: []string{"eval", "loss"}},
+			{Key: "a"},
+			{Key: "missing"},
+		},
+	})
+	inChan <- makeFlushSummaryRecord()
+
+	summary := getSummary(inChan, outChan)
+	assert.JSONEq(t,
+		`{"metrics": {"accuracy": 0.9, "f1": 0.4}, "new": {"x": 1}}`,
+		summary["eval"])
+	assert.Equal(t, "2", summary["b"])
+	assert.NotContains(t, summary, "a")
+
+	// the removed key is forwarded, so that it is removed from the run
+	var removed []string
+	for len(fwdChan) > 0 {
+		for _, item := range (<-fwdChan).GetSummary().GetRemove() {
+			removed = append(removed, item.Key)
+		}
+	}
+	assert.Equal(t, []string{"a"}, removed)
+}
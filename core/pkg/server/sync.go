@@ -4,12 +4,39 @@ import (
 	"context"
 	"fmt"
 	"io"
+	"path/filepath"
+	"strings"
 	"sync"
 
 	"github.com/wandb/wandb/core/pkg/observability"
 	"github.com/wandb/wandb/core/pkg/service"
 )
 
+// uploadErrors keeps the first error that kept data of a run from being
+// uploaded, which is the result of syncing the run.
+//
+// Errors are added from the goroutines that upload the run's data.
+type uploadErrors struct {
+	mu  sync.Mutex
+	err error
+}
+
+// add records an error unless there was one before.
+func (e *uploadErrors) add(err error) {
+	e.mu.Lock()
+	defer e.mu.Unlock()
+	if e.err == nil {
+		e.err = err
+	}
+}
+
+// first returns the first error added, or nil.
+func (e *uploadErrors) first() error {
+	e.mu.Lock()
+	defer e.mu.Unlock()
+	return e.err
+}
+
 type SyncService struct {
 	ctx        context.Context
 	wg         sync.WaitGroup
@@ -18,10 +45,14 @@ type SyncService struct {
 	inChan     chan *service.Record
 	// Result of offline sync to pass to the client when syncing is done
 	flushCallback func(error)
-	exitSeen      bool
-	syncErr       error
-	overwrite     *service.SyncOverwrite
-	skip          *service.SyncSkip
+	// exitSeen is whether SyncRecord was given the run's exit record; it is
+	// only used on the goroutine that calls SyncRecord
+	exitSeen  bool
+	syncErr   error
+	overwrite *service.SyncOverwrite
+	skip      *service.SyncSkip
+	// skipTypes are the names of the record types to drop
+	skipTypes map[string]bool
 }
 
 type SyncServiceOption func(*SyncService)
@@ -35,6 +66,15 @@ func NewSyncService(ctx context.Context, opts ...SyncServiceOption) *SyncService
 	for _, opt := range opts {
 		opt(syncService)
 	}
+
+	syncService.skipTypes = make(map[string]bool)
+	for _, name := range syncService.skip.GetRecordTypes() {
+		syncService.skipTypes[name] = true
+	}
+	// the run and its end are needed to sync anything
+	delete(syncService.skipTypes, "run")
+	delete(syncService.skipTypes, "exit")
+
 	return syncService
 }
 
@@ -72,6 +112,9 @@ func (s *SyncService) SyncRecord(record *service.Record, err error) {
 	if err != nil && err != io.EOF {
 		s.syncErr = err
 	}
+	if record.GetExit() != nil {
+		s.exitSeen = true
+	}
 
 	if err != nil && !s.exitSeen {
 		record = &service.Record{
@@ -104,9 +147,16 @@ func (s *SyncService) sync() {
 		// is used for something else, we should re-evaluate this.
 		// remove the control from the record:
 		record.Control = nil
+		if s.skipTypes[recordTypeName(record)] {
+			continue
+		}
 		switch record.RecordType.(type) {
 		case *service.Record_Run:
 			s.syncRun(record)
+		case *service.Record_History:
+			s.syncHistory(record)
+		case *service.Record_Summary:
+			s.syncSummary(record)
 		case *service.Record_OutputRaw:
 			s.syncOutputRaw(record)
 		case *service.Record_Exit:
@@ -129,6 +179,21 @@ func (s *SyncService) syncRun(record *service.Record) {
 		if s.overwrite.GetRunId() != "" {
 			record.GetRun().RunId = s.overwrite.GetRunId()
 		}
+		if s.overwrite.GetGroup() != "" {
+			record.GetRun().RunGroup = s.overwrite.GetGroup()
+		}
+		if s.overwrite.GetJobType() != "" {
+			record.GetRun().JobType = s.overwrite.GetJobType()
+		}
+		if len(s.overwrite.GetTags()) > 0 {
+			record.GetRun().Tags = s.overwrite.GetTags()
+		}
+		if s.overwrite.GetNotes() != "" {
+			record.GetRun().Notes = s.overwrite.GetNotes()
+		}
+		if s.overwrite.GetDisplayName() != "" {
+			record.GetRun().DisplayName = s.overwrite.GetDisplayName()
+		}
 	}
 	s.senderFunc(record)
 	record = &service.Record{
@@ -144,10 +209,80 @@ func (s *SyncService) syncRun(record *service.Record) {
 }
 
 func (s *SyncService) syncExit(record *service.Record) {
-	s.exitSeen = true
 	s.senderFunc(record)
 }
 
+// syncHistory drops the history items that match a skipped key pattern.
+//
+// Keys that start with an underscore, like _step, are always kept.
+func (s *SyncService) syncHistory(record *service.Record) {
+	patterns := s.skip.GetHistoryKeys()
+	if len(patterns) == 0 {
+		s.senderFunc(record)
+		return
+	}
+
+	history := record.GetHistory()
+	items := history.Item[:0]
+	for _, item := range history.GetItem() {
+		if !skipKey(item.GetKey(), item.GetNestedKey(), patterns) {
+			items = append(items, item)
+		}
+	}
+	history.Item = items
+	s.senderFunc(record)
+}
+
+// syncSummary drops the summary updates of keys that are skipped in the
+// history, so that they do not reach the run through its summary.
+func (s *SyncService) syncSummary(record *service.Record) {
+	patterns := s.skip.GetHistoryKeys()
+	if len(patterns) == 0 {
+		s.senderFunc(record)
+		return
+	}
+
+	summary := record.GetSummary()
+	items := summary.Update[:0]
+	for _, item := range summary.GetUpdate() {
+		if !skipKey(item.GetKey(), item.GetNestedKey(), patterns) {
+			items = append(items, item)
+		}
+	}
+	summary.Update = items
+	s.senderFunc(record)
+}
+
+// skipKey returns whether a history or summary key matches a skipped key
+// pattern. Keys that start with an underscore are never skipped.
+func skipKey(key string, nestedKey []string, patterns []string) bool {
+	if len(nestedKey) > 0 {
+		key = strings.Join(nestedKey, ".")
+	}
+	if strings.HasPrefix(key, "_") {
+		return false
+	}
+
+	for _, pattern := range patterns {
+		if match, _ := filepath.Match(pattern, key); match {
+			return true
+		}
+	}
+	return false
+}
+
+// recordTypeName returns the name of the record's type in the Record
+// message, e.g. "stats" for a StatsRecord.
+func recordTypeName(record *service.Record) string {
+	message := record.ProtoReflect()
+	oneof := message.Descriptor().Oneofs().ByName("record_type")
+	field := message.WhichOneof(oneof)
+	if field == nil {
+		return ""
+	}
+	return string(field.Name())
+}
+
 func (s *SyncService) syncOutputRaw(record *service.Record) {
 	if s.skip != nil && s.skip.GetOutputRaw() {
 		return
@@ -0,0 +1,283 @@
+package server
+
+import (
+	"context"
+	"path/filepath"
+	"sync"
+
+	"google.golang.org/protobuf/proto"
+	"google.golang.org/protobuf/types/known/wrapperspb"
+
+	"github.com/wandb/wandb/core/internal/api"
+	"github.com/wandb/wandb/core/internal/filetransfer"
+	"github.com/wandb/wandb/core/internal/settings"
+	"github.com/wandb/wandb/core/internal/shared"
+	"github.com/wandb/wandb/core/pkg/observability"
+	"github.com/wandb/wandb/core/pkg/service"
+)
+
+const (
+	batchSyncConnectionId  = "batch-sync"
+	defaultSyncParallelism = 4
+)
+
+// BatchSyncProgress reports that one run of a batch finished syncing.
+type BatchSyncProgress struct {
+	// Path is the transaction log of the run
+	Path string
+
+	// Response is the response to the run's sync request
+	Response *service.SyncResponse
+
+	// Done is the number of runs of the batch that finished, including
+	// this one
+	Done int
+
+	// Total is the number of runs in the batch
+	Total int
+}
+
+type BatchSyncParams struct {
+	Logger *observability.CoreLogger
+
+	// Settings are the settings shared by all runs
+	//
+	// Every run uses a copy that points at its transaction log. The
+	// settings must not be offline.
+	Settings *settings.Settings
+
+	// Paths are the transaction logs to sync
+	Paths []string
+
+	// Parallelism is the maximum number of runs synced at the same time
+	Parallelism int
+
+	// Overwrite and Skip are passed to the sync request of every run
+	Overwrite *service.SyncOverwrite
+	Skip      *service.SyncSkip
+
+	// Follow is whether to keep syncing the runs as their logs are
+	// written, until every run exits
+	Follow bool
+
+	// Backend is the backend shared by all runs
+	//
+	// If nil, one is created from Settings.
+	Backend *api.Backend
+
+	// FileTransferManager uploads the files of all runs
+	//
+	// If nil, one is created from Settings. BatchSync starts and closes
+	// the manager it creates; a manager passed in must be started by the
+	// caller.
+	FileTransferManager filetransfer.FileTransferManager
+
+	// OnProgress, if set, is called as each run finishes syncing
+	//
+	// It is called from a single goroutine at a time.
+	OnProgress func(BatchSyncProgress)
+}
+
+// BatchSync uploads many offline runs from the same process.
+//
+// Each run is synced by its own stream exactly like `wandb sync` does
+// with a single run, but the streams share a backend and a file transfer
+// manager, so that the number of connections and concurrent uploads stays
+// bounded no matter how many runs are synced.
+type BatchSync struct {
+	logger      *observability.CoreLogger
+	settings    *settings.Settings
+	paths       []string
+	parallelism int
+	overwrite   *service.SyncOverwrite
+	skip        *service.SyncSkip
+	follow      bool
+	onProgress  func(BatchSyncProgress)
+
+	backend             *api.Backend
+	fileTransferManager filetransfer.FileTransferManager
+
+	// ownsFileTransferManager is whether BatchSync created the manager
+	ownsFileTransferManager bool
+
+	// mu guards done and calls to onProgress
+	mu   sync.Mutex
+	done int
+}
+
+func NewBatchSync(params BatchSyncParams) *BatchSync {
+	b := &BatchSync{
+		logger:              params.Logger,
+		settings:            params.Settings,
+		paths:               params.Paths,
+		parallelism:         params.Parallelism,
+		overwrite:           params.Overwrite,
+		skip:                params.Skip,
+		follow:              params.Follow,
+		onProgress:          params.OnProgress,
+		backend:             params.Backend,
+		fileTransferManager: params.FileTransferManager,
+	}
+
+	if b.parallelism <= 0 {
+		b.parallelism = defaultSyncParallelism
+	}
+	if b.backend == nil {
+		b.backend = NewBackend(b.logger, b.settings)
+	}
+	if b.fileTransferManager == nil && b.backend != nil {
+		b.fileTransferManager = NewFileTransferManager(
+			filetransfer.NewFileTransferStats(),
+			b.logger,
+			b.settings,
+		)
+		b.ownsFileTransferManager = true
+	}
+
+	return b
+}
+
+// Run syncs all runs and returns their sync responses in the order of
+// the paths.
+//
+// A run that could not be synced has a response with an error; Run
+// itself only stops early if ctx is canceled, in which case the runs that
+// were not started have no response.
+func (b *BatchSync) Run(ctx context.Context) []*service.SyncResponse {
+	if b.ownsFileTransferManager {
+		b.fileTransferManager.Start()
+		defer b.fileTransferManager.Close()
+	}
+
+	responses := make([]*service.SyncResponse, len(b.paths))
+	semaphore := make(chan struct{}, b.parallelism)
+	wg := sync.WaitGroup{}
+
+	for i, path := range b.paths {
+		select {
+		case semaphore <- struct{}{}:
+		case <-ctx.Done():
+			wg.Wait()
+			return responses
+		}
+
+		wg.Add(1)
+		go func(i int, path string) {
+			defer func() {
+				<-semaphore
+				wg.Done()
+			}()
+
+			responses[i] = b.syncRun(ctx, path)
+			b.progress(path, responses[i])
+		}(i, path)
+	}
+
+	wg.Wait()
+	return responses
+}
+
+// progress reports that the run in path finished syncing.
+func (b *BatchSync) progress(path string, response *service.SyncResponse) {
+	b.mu.Lock()
+	defer b.mu.Unlock()
+
+	b.done++
+	if response.GetError() != nil {
+		b.logger.Warn(
+			"batch sync: failed to sync run",
+			"path", path,
+			"error", response.GetError().GetMessage(),
+		)
+	} else {
+		b.logger.Info("batch sync: synced run", "path", path, "url", response.GetUrl())
+	}
+
+	if b.onProgress != nil {
+		b.onProgress(BatchSyncProgress{
+			Path:     path,
+			Response: response,
+			Done:     b.done,
+			Total:    len(b.paths),
+		})
+	}
+}
+
+// runSettings returns the settings of the stream that syncs the run in path.
+func (b *BatchSync) runSettings(path string) *settings.Settings {
+	settingsProto := proto.Clone(b.settings.Proto).(*service.Settings)
+	dir := filepath.Dir(path)
+
+	settingsProto.XSync = &wrapperspb.BoolValue{Value: true}
+	settingsProto.SyncFile = &wrapperspb.StringValue{Value: path}
+	settingsProto.SyncDir = &wrapperspb.StringValue{Value: dir}
+	settingsProto.FilesDir = &wrapperspb.StringValue{Value: filepath.Join(dir, "files")}
+	settingsProto.RunId = &wrapperspb.StringValue{Value: shared.ShortID(8)}
+
+	return settings.From(settingsProto)
+}
+
+// syncRun syncs the run in path with its own stream.
+func (b *BatchSync) syncRun(ctx context.Context, path string) *service.SyncResponse {
+	opts := []StreamOption{
+		WithStreamLogger(b.logger),
+		WithStreamBackend(b.backend),
+	}
+	if b.fileTransferManager != nil {
+		opts = append(opts, WithStreamFileTransferManager(b.fileTransferManager))
+	}
+
+	stream := NewStream(ctx, b.runSettings(path), "", opts...)
+	responder := &syncResponder{responses: make(chan *service.SyncResponse, 1)}
+	stream.AddResponders(ResponderEntry{responder, batchSyncConnectionId})
+	stream.Start()
+
+	stream.HandleRecord(&service.Record{
+		RecordType: &service.Record_Request{
+			Request: &service.Request{
+				RequestType: &service.Request_Sync{
+					Sync: &service.SyncRequest{
+						StartOffset: 0,
+						FinalOffset: -1,
+						Overwrite:   b.overwrite,
+						Skip:        b.skip,
+						Follow:      b.follow,
+					},
+				},
+			},
+		},
+		Control: &service.Control{ConnectionId: batchSyncConnectionId, ReqResp: true},
+	})
+
+	// the stream finishes on its own once the whole log is sent
+	stream.Close()
+
+	select {
+	case response := <-responder.responses:
+		return response
+	default:
+		return &service.SyncResponse{
+			Error: &service.ErrorInfo{
+				Message: "sync finished without a response",
+				Code:    service.ErrorInfo_UNKNOWN,
+			},
+		}
+	}
+}
+
+// syncResponder keeps the sync response of a stream.
+type syncResponder struct {
+	responses chan *service.SyncResponse
+}
+
+func (r *syncResponder) Respond(response *service.ServerResponse) {
+	syncResponse := response.GetResultCommunicate().GetResponse().GetSyncResponse()
+	if syncResponse == nil {
+		return
+	}
+
+	select {
+	case r.responses <- syncResponse:
+	default:
+	}
+}
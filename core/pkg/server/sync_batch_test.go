@@ -0,0 +1,75 @@
+package server_test
+
+import (
+	"context"
+	"net/http"
+	"net/http/httptest"
+	"os"
+	"path/filepath"
+	"sync"
+	"testing"
+
+	"github.com/stretchr/testify/assert"
+	"google.golang.org/protobuf/types/known/wrapperspb"
+
+	"github.com/wandb/wandb/core/internal/settings"
+	"github.com/wandb/wandb/core/pkg/observability"
+	"github.com/wandb/wandb/core/pkg/server"
+	"github.com/wandb/wandb/core/pkg/service"
+)
+
+func TestBatchSync(t *testing.T) {
+	// every run is synced even though its requests fail, and the failures
+	// are reported
+	backend := httptest.NewServer(http.HandlerFunc(func(w http.ResponseWriter, r *http.Request) {
+		w.WriteHeader(http.StatusBadRequest)
+	}))
+	defer backend.Close()
+
+	dir := t.TempDir()
+	var paths []string
+	for _, runID := range []string{"run1", "run2", "run3"} {
+		path := filepath.Join(dir, runID, "run-"+runID+".wandb")
+		assert.NoError(t, os.MkdirAll(filepath.Dir(path), 0755))
+		store := server.NewStore(context.Background(), path, observability.NewNoOpLogger())
+		assert.NoError(t, store.Open(os.O_WRONLY))
+		assert.NoError(t, store.Write(&service.Record{
+			Num: 1,
+			RecordType: &service.Record_Run{Run: &service.RunRecord{
+				RunId:  runID,
+				Config: &service.ConfigRecord{},
+			}},
+		}))
+		assert.NoError(t, store.Close())
+		paths = append(paths, path)
+	}
+	paths = append(paths, filepath.Join(dir, "missing.wandb"))
+
+	mu := sync.Mutex{}
+	var done []int
+	batch := server.NewBatchSync(server.BatchSyncParams{
+		Logger: observability.NewNoOpLogger(),
+		Settings: settings.From(&service.Settings{
+			BaseUrl: wrapperspb.String(backend.URL),
+			ApiKey:  wrapperspb.String("test-key"),
+		}),
+		Paths:       paths,
+		Parallelism: 2,
+		Overwrite:   &service.SyncOverwrite{Entity: "entity", Project: "project"},
+		OnProgress: func(progress server.BatchSyncProgress) {
+			mu.Lock()
+			defer mu.Unlock()
+			assert.Equal(t, 4, progress.Total)
+			done = append(done, progress.Done)
+		},
+	})
+	responses := batch.Run(context.Background())
+
+	assert.Equal(t, []int{1, 2, 3, 4}, done)
+	assert.Len(t, responses, 4)
+	for i, runID := range []string{"run1", "run2", "run3"} {
+		assert.Contains(t, responses[i].GetError().GetMessage(), "upsert bucket")
+		assert.Equal(t, backend.URL+"/entity/project/runs/"+runID, responses[i].GetUrl())
+	}
+	assert.Contains(t, responses[3].GetError().GetMessage(), "missing.wandb")
+}
@@ -76,6 +76,124 @@ func TestSyncService(t *testing.T) {
 		assert.IsType(t, &service.Record_Request{}, mockSender.Records[1].RecordType)
 	})
 
+	// Test syncRun with overwrite of the run's metadata
+	t.Run("syncRun with metadata overwrite", func(t *testing.T) {
+		run := &service.Record{
+			RecordType: &service.Record_Run{
+				Run: &service.RunRecord{
+					RunGroup: "oldGroup",
+					Tags:     []string{"old"},
+					Notes:    "oldNotes",
+				},
+			},
+		}
+		overwrite := &service.SyncOverwrite{
+			Group:       "testGroup",
+			JobType:     "testJobType",
+			Tags:        []string{"a", "b"},
+			DisplayName: "testName",
+		}
+		mockSender := MockSender{}
+		syncService := server.NewSyncService(context.Background(),
+			server.WithSyncServiceSenderFunc(mockSender.Send),
+			server.WithSyncServiceOverwrite(overwrite),
+		)
+		syncService.Start()
+		syncService.SyncRecord(run, nil)
+		syncService.Close()
+		modifiedRun := mockSender.Records[0].GetRun()
+		assert.Equal(t, "testGroup", modifiedRun.RunGroup)
+		assert.Equal(t, "testJobType", modifiedRun.JobType)
+		assert.Equal(t, []string{"a", "b"}, modifiedRun.Tags)
+		assert.Equal(t, "oldNotes", modifiedRun.Notes)
+		assert.Equal(t, "testName", modifiedRun.DisplayName)
+	})
+
+	// Test skipping record types
+	t.Run("sync with skipped record types", func(t *testing.T) {
+		skip := &service.SyncSkip{
+			RecordTypes: []string{"stats", "tbrecord", "exit"},
+		}
+		mockSender := MockSender{}
+		syncService := server.NewSyncService(context.Background(),
+			server.WithSyncServiceSenderFunc(mockSender.Send),
+			server.WithSyncServiceSkip(skip),
+		)
+		syncService.Start()
+		syncService.SyncRecord(&service.Record{RecordType: &service.Record_Stats{}}, nil)
+		syncService.SyncRecord(&service.Record{RecordType: &service.Record_Tbrecord{}}, nil)
+		syncService.SyncRecord(&service.Record{RecordType: &service.Record_Config{}}, nil)
+		syncService.SyncRecord(&service.Record{RecordType: &service.Record_Exit{}}, nil)
+		syncService.Close()
+		assert.Equal(t, 2, len(mockSender.Records))
+		assert.IsType(t, &service.Record_Config{}, mockSender.Records[0].RecordType)
+		assert.IsType(t, &service.Record_Exit{}, mockSender.Records[1].RecordType)
+	})
+
+	// Test skipping history keys
+	t.Run("syncHistory with skipped keys", func(t *testing.T) {
+		skip := &service.SyncSkip{
+			HistoryKeys: []string{"debug/*", "_*", "grad"},
+		}
+		mockSender := MockSender{}
+		syncService := server.NewSyncService(context.Background(),
+			server.WithSyncServiceSenderFunc(mockSender.Send),
+			server.WithSyncServiceSkip(skip),
+		)
+		syncService.Start()
+		syncService.SyncRecord(&service.Record{
+			RecordType: &service.Record_History{
+				History: &service.HistoryRecord{
+					Item: []*service.HistoryItem{
+						{Key: "_step", ValueJson: "1"},
+						{Key: "loss", ValueJson: "0.5"},
+						{Key: "debug/lr", ValueJson: "0.1"},
+						{NestedKey: []string{"grad"}, ValueJson: "2"},
+					},
+				},
+			},
+		}, nil)
+		syncService.Close()
+		assert.Equal(t, 1, len(mockSender.Records))
+		var keys []string
+		for _, item := range mockSender.Records[0].GetHistory().GetItem() {
+			keys = append(keys, item.GetKey())
+		}
+		assert.Equal(t, []string{"_step", "loss"}, keys)
+	})
+
+	// Test skipping the same keys in the summary
+	t.Run("syncSummary with skipped keys", func(t *testing.T) {
+		skip := &service.SyncSkip{
+			HistoryKeys: []string{"debug/*", "grad.*", "_*"},
+		}
+		mockSender := MockSender{}
+		syncService := server.NewSyncService(context.Background(),
+			server.WithSyncServiceSenderFunc(mockSender.Send),
+			server.WithSyncServiceSkip(skip),
+		)
+		syncService.Start()
+		syncService.SyncRecord(&service.Record{
+			RecordType: &service.Record_Summary{
+				Summary: &service.SummaryRecord{
+					Update: []*service.SummaryItem{
+						{Key: "_runtime", ValueJson: "10"},
+						{Key: "loss", ValueJson: "0.5"},
+						{Key: "debug/lr", ValueJson: "0.1"},
+						{NestedKey: []string{"grad", "norm"}, ValueJson: "2"},
+					},
+				},
+			},
+		}, nil)
+		syncService.Close()
+		assert.Equal(t, 1, len(mockSender.Records))
+		var keys []string
+		for _, item := range mockSender.Records[0].GetSummary().GetUpdate() {
+			keys = append(keys, item.GetKey())
+		}
+		assert.Equal(t, []string{"_runtime", "loss"}, keys)
+	})
+
 	// Test syncOutputRaw
 	t.Run("syncOutputRaw", func(t *testing.T) {
 		syncService, mockSender := createSyncService()
@@ -17,6 +17,24 @@ func WithWriterFwdChannel(fwd chan *service.Record) WriterOption {
 	}
 }
 
+func WithWriterCheckpoint(checkpoint *Checkpoint) WriterOption {
+	return func(w *Writer) {
+		w.checkpoint = checkpoint
+	}
+}
+
+func WithWriterStoreOptions(opts ...StoreOption) WriterOption {
+	return func(w *Writer) {
+		w.storeOpts = opts
+	}
+}
+
+func WithWriterCompaction(compact bool) WriterOption {
+	return func(w *Writer) {
+		w.compact = compact
+	}
+}
+
 func WithWriterSettings(settings *service.Settings) WriterOption {
 	return func(w *Writer) {
 		w.settings = settings
@@ -49,6 +67,15 @@ type Writer struct {
 	// recordNum is the running count of stored records
 	recordNum int64
 
+	// checkpoint tracks the offsets of the stored records
+	checkpoint *Checkpoint
+
+	// storeOpts are the options for the store
+	storeOpts []StoreOption
+
+	// compact is whether to compact the store once it is closed
+	compact bool
+
 	// wg is the wait group for the writer
 	wg sync.WaitGroup
 }
@@ -75,7 +102,7 @@ func (w *Writer) startStore() {
 	w.storeChan = make(chan *service.Record, BufferSize*8)
 
 	var err error
-	w.store = NewStore(w.ctx, w.settings.GetSyncFile().GetValue(), w.logger)
+	w.store = NewStore(w.ctx, w.settings.GetSyncFile().GetValue(), w.logger, w.storeOpts...)
 	err = w.store.Open(os.O_WRONLY)
 	if err != nil {
 		w.logger.CaptureFatalAndPanic("writer: startStore: error creating store", err)
@@ -86,16 +113,46 @@ func (w *Writer) startStore() {
 		for record := range w.storeChan {
 			if err = w.store.Write(record); err != nil {
 				w.logger.Error("writer: startStore: error storing record", "error", err)
+				continue
+			}
+			if offset, err := w.store.LastRecordOffset(); err == nil {
+				w.checkpoint.Stored(record, offset)
 			}
 		}
 
 		if err = w.store.Close(); err != nil {
 			w.logger.CaptureError("writer: startStore: error closing store", err)
+		} else if w.compact {
+			w.compactStore()
 		}
 		w.wg.Done()
 	}()
 }
 
+// compactStore replaces the closed store with a compacted copy.
+func (w *Writer) compactStore() {
+	name := w.settings.GetSyncFile().GetValue()
+	compacted := name + ".compact"
+
+	stats, err := CompactStore(w.ctx, w.logger, name, compacted, w.storeOpts...)
+	if err != nil {
+		w.logger.CaptureError("writer: compactStore: error compacting store", err)
+		if err := RemoveStore(compacted); err != nil {
+			w.logger.CaptureError("writer: compactStore: error removing compacted store", err)
+		}
+		return
+	}
+	if err := RenameStore(compacted, name); err != nil {
+		w.logger.CaptureError("writer: compactStore: error replacing store", err)
+		return
+	}
+	w.logger.Info(
+		"writer: compactStore: compacted store",
+		"read", stats.Read,
+		"written", stats.Written,
+	)
+}
+
 // Do is the main loop of the writer to process incoming messages
 func (w *Writer) Do(inChan <-chan *service.Record) {
 	defer w.logger.Reraise()
@@ -132,8 +189,9 @@ func (w *Writer) writeRecord(record *service.Record) {
 	case nil:
 		w.logger.Error("writer: writeRecord: nil record type")
 	default:
-		w.fwdRecord(record)
+		// the record is numbered before the sender can see it
 		w.storeRecord(record)
+		w.fwdRecord(record)
 	}
 }
 
@@ -503,6 +503,14 @@ type Settings struct {
 	XStatsBufferSize                 *wrapperspb.Int32Value   `protobuf:"bytes,161,opt,name=_stats_buffer_size,json=StatsBufferSize,proto3" json:"_stats_buffer_size,omitempty"`
 	XShared                          *wrapperspb.BoolValue    `protobuf:"bytes,162,opt,name=_shared,json=Shared,proto3" json:"_shared,omitempty"`
 	XCodePathLocal                   *wrapperspb.StringValue  `protobuf:"bytes,163,opt,name=_code_path_local,json=CodePathLocal,proto3" json:"_code_path_local,omitempty"`
+	XSyncFileSegmentMb               *wrapperspb.Int32Value   `protobuf:"bytes,166,opt,name=_sync_file_segment_mb,json=SyncFileSegmentMb,proto3" json:"_sync_file_segment_mb,omitempty"`
+	XSyncFileCompact                 *wrapperspb.BoolValue    `protobuf:"bytes,167,opt,name=_sync_file_compact,json=SyncFileCompact,proto3" json:"_sync_file_compact,omitempty"`
+	XSyncFileCompression             *wrapperspb.StringValue  `protobuf:"bytes,168,opt,name=_sync_file_compression,json=SyncFileCompression,proto3" json:"_sync_file_compression,omitempty"`
+	XFileStreamGzip                  *wrapperspb.BoolValue    `protobuf:"bytes,169,opt,name=_file_stream_gzip,json=FileStreamGzip,proto3" json:"_file_stream_gzip,omitempty"`
+	XFileStreamMirrorDir             *wrapperspb.StringValue  `protobuf:"bytes,170,opt,name=_file_stream_mirror_dir,json=FileStreamMirrorDir,proto3" json:"_file_stream_mirror_dir,omitempty"`
+	XFileStreamMirrorUrl             *wrapperspb.StringValue  `protobuf:"bytes,171,opt,name=_file_stream_mirror_url,json=FileStreamMirrorUrl,proto3" json:"_file_stream_mirror_url,omitempty"`
+	XFileStreamMirrorApiKey          *wrapperspb.StringValue  `protobuf:"bytes,172,opt,name=_file_stream_mirror_api_key,json=FileStreamMirrorApiKey,proto3" json:"_file_stream_mirror_api_key,omitempty"`
+	XSharedHistoryMerge              *wrapperspb.StringValue  `protobuf:"bytes,173,opt,name=_shared_history_merge,json=SharedHistoryMerge,proto3" json:"_shared_history_merge,omitempty"`
 	XProxies                         *MapStringKeyStringValue `protobuf:"bytes,200,opt,name=_proxies,json=Proxies,proto3" json:"_proxies,omitempty"`
 }
 
@@ -1686,6 +1694,62 @@ func (x *Settings) GetXCodePathLocal() *wrapperspb.StringValue {
 	return nil
 }
 
+func (x *Settings) GetXSyncFileSegmentMb() *wrapperspb.Int32Value {
+	if x != nil {
+		return x.XSyncFileSegmentMb
+	}
+	return nil
+}
+
+func (x *Settings) GetXSyncFileCompact() *wrapperspb.BoolValue {
+	if x != nil {
+		return x.XSyncFileCompact
+	}
+	return nil
+}
+
+func (x *Settings) GetXSyncFileCompression() *wrapperspb.StringValue {
+	if x != nil {
+		return x.XSyncFileCompression
+	}
+	return nil
+}
+
+func (x *Settings) GetXFileStreamGzip() *wrapperspb.BoolValue {
+	if x != nil {
+		return x.XFileStreamGzip
+	}
+	return nil
+}
+
+func (x *Settings) GetXFileStreamMirrorDir() *wrapperspb.StringValue {
+	if x != nil {
+		return x.XFileStreamMirrorDir
+	}
+	return nil
+}
+
+func (x *Settings) GetXFileStreamMirrorUrl() *wrapperspb.StringValue {
+	if x != nil {
+		return x.XFileStreamMirrorUrl
+	}
+	return nil
+}
+
+func (x *Settings) GetXFileStreamMirrorApiKey() *wrapperspb.StringValue {
+	if x != nil {
+		return x.XFileStreamMirrorApiKey
+	}
+	return nil
+}
+
+func (x *Settings) GetXSharedHistoryMerge() *wrapperspb.StringValue {
+	if x != nil {
+		return x.XSharedHistoryMerge
+	}
+	return nil
+}
+
 func (x *Settings) GetXProxies() *MapStringKeyStringValue {
 	if x != nil {
 		return x.XProxies
@@ -1743,7 +1807,7 @@ var file_wandb_proto_wandb_settings_proto_rawDesc = []byte{
 	0x18, 0x01, 0x20, 0x01, 0x28, 0x09, 0x52, 0x03, 0x72, 0x75, 0x6e, 0x12, 0x14, 0x0a, 0x05, 0x76,
 	0x61, 0x6c, 0x75, 0x65, 0x18, 0x02, 0x20, 0x01, 0x28, 0x01, 0x52, 0x05, 0x76, 0x61, 0x6c, 0x75,
 	0x65, 0x12, 0x16, 0x0a, 0x06, 0x6d, 0x65, 0x74, 0x72, 0x69, 0x63, 0x18, 0x03, 0x20, 0x01, 0x28,
-	0x09, 0x52, 0x06, 0x6d, 0x65, 0x74, 0x72, 0x69, 0x63, 0x22, 0xfa, 0x56, 0x0a, 0x08, 0x53, 0x65,
+	0x09, 0x52, 0x06, 0x6d, 0x65, 0x74, 0x72, 0x69, 0x63, 0x22, 0x88, 0x5c, 0x0a, 0x08, 0x53, 0x65,
 	0x74, 0x74, 0x69, 0x6e, 0x67, 0x73, 0x12, 0x35, 0x0a, 0x07, 0x61, 0x70, 0x69, 0x5f, 0x6b, 0x65,
 	0x79, 0x18, 0x37, 0x20, 0x01, 0x28, 0x0b, 0x32, 0x1c, 0x2e, 0x67, 0x6f, 0x6f, 0x67, 0x6c, 0x65,
 	0x2e, 0x70, 0x72, 0x6f, 0x74, 0x6f, 0x62, 0x75, 0x66, 0x2e, 0x53, 0x74, 0x72, 0x69, 0x6e, 0x67,
@@ -2435,11 +2499,52 @@ var file_wandb_proto_wandb_settings_proto_rawDesc = []byte{
 	0x20, 0x01, 0x28, 0x0b, 0x32, 0x1c, 0x2e, 0x67, 0x6f, 0x6f, 0x67, 0x6c, 0x65, 0x2e, 0x70, 0x72,
 	0x6f, 0x74, 0x6f, 0x62, 0x75, 0x66, 0x2e, 0x53, 0x74, 0x72, 0x69, 0x6e, 0x67, 0x56, 0x61, 0x6c,
 	0x75, 0x65, 0x52, 0x0d, 0x43, 0x6f, 0x64, 0x65, 0x50, 0x61, 0x74, 0x68, 0x4c, 0x6f, 0x63, 0x61,
-	0x6c, 0x12, 0x43, 0x0a, 0x08, 0x5f, 0x70, 0x72, 0x6f, 0x78, 0x69, 0x65, 0x73, 0x18, 0xc8, 0x01,
-	0x20, 0x01, 0x28, 0x0b, 0x32, 0x27, 0x2e, 0x77, 0x61, 0x6e, 0x64, 0x62, 0x5f, 0x69, 0x6e, 0x74,
-	0x65, 0x72, 0x6e, 0x61, 0x6c, 0x2e, 0x4d, 0x61, 0x70, 0x53, 0x74, 0x72, 0x69, 0x6e, 0x67, 0x4b,
-	0x65, 0x79, 0x53, 0x74, 0x72, 0x69, 0x6e, 0x67, 0x56, 0x61, 0x6c, 0x75, 0x65, 0x52, 0x07, 0x50,
-	0x72, 0x6f, 0x78, 0x69, 0x65, 0x73, 0x62, 0x06, 0x70, 0x72, 0x6f, 0x74, 0x6f, 0x33,
+	0x6c, 0x12, 0x4e, 0x0a, 0x15, 0x5f, 0x73, 0x79, 0x6e, 0x63, 0x5f, 0x66, 0x69, 0x6c, 0x65, 0x5f,
+	0x73, 0x65, 0x67, 0x6d, 0x65, 0x6e, 0x74, 0x5f, 0x6d, 0x62, 0x18, 0xa6, 0x01, 0x20, 0x01, 0x28,
+	0x0b, 0x32, 0x1b, 0x2e, 0x67, 0x6f, 0x6f, 0x67, 0x6c, 0x65, 0x2e, 0x70, 0x72, 0x6f, 0x74, 0x6f,
+	0x62, 0x75, 0x66, 0x2e, 0x49, 0x6e, 0x74, 0x33, 0x32, 0x56, 0x61, 0x6c, 0x75, 0x65, 0x52, 0x11,
+	0x53, 0x79, 0x6e, 0x63, 0x46, 0x69, 0x6c, 0x65, 0x53, 0x65, 0x67, 0x6d, 0x65, 0x6e, 0x74, 0x4d,
+	0x62, 0x12, 0x48, 0x0a, 0x12, 0x5f, 0x73, 0x79, 0x6e, 0x63, 0x5f, 0x66, 0x69, 0x6c, 0x65, 0x5f,
+	0x63, 0x6f, 0x6d, 0x70, 0x61, 0x63, 0x74, 0x18, 0xa7, 0x01, 0x20, 0x01, 0x28, 0x0b, 0x32, 0x1a,
+	0x2e, 0x67, 0x6f, 0x6f, 0x67, 0x6c, 0x65, 0x2e, 0x70, 0x72, 0x6f, 0x74, 0x6f, 0x62, 0x75, 0x66,
+	0x2e, 0x42, 0x6f, 0x6f, 0x6c, 0x56, 0x61, 0x6c, 0x75, 0x65, 0x52, 0x0f, 0x53, 0x79, 0x6e, 0x63,
+	0x46, 0x69, 0x6c, 0x65, 0x43, 0x6f, 0x6d, 0x70, 0x61, 0x63, 0x74, 0x12, 0x52, 0x0a, 0x16, 0x5f,
+	0x73, 0x79, 0x6e, 0x63, 0x5f, 0x66, 0x69, 0x6c, 0x65, 0x5f, 0x63, 0x6f, 0x6d, 0x70, 0x72, 0x65,
+	0x73, 0x73, 0x69, 0x6f, 0x6e, 0x18, 0xa8, 0x01, 0x20, 0x01, 0x28, 0x0b, 0x32, 0x1c, 0x2e, 0x67,
+	0x6f, 0x6f, 0x67, 0x6c, 0x65, 0x2e, 0x70, 0x72, 0x6f, 0x74, 0x6f, 0x62, 0x75, 0x66, 0x2e, 0x53,
+	0x74, 0x72, 0x69, 0x6e, 0x67, 0x56, 0x61, 0x6c, 0x75, 0x65, 0x52, 0x13, 0x53, 0x79, 0x6e, 0x63,
+	0x46, 0x69, 0x6c, 0x65, 0x43, 0x6f, 0x6d, 0x70, 0x72, 0x65, 0x73, 0x73, 0x69, 0x6f, 0x6e, 0x12,
+	0x46, 0x0a, 0x11, 0x5f, 0x66, 0x69, 0x6c, 0x65, 0x5f, 0x73, 0x74, 0x72, 0x65, 0x61, 0x6d, 0x5f,
+	0x67, 0x7a, 0x69, 0x70, 0x18, 0xa9, 0x01, 0x20, 0x01, 0x28, 0x0b, 0x32, 0x1a, 0x2e, 0x67, 0x6f,
+	0x6f, 0x67, 0x6c, 0x65, 0x2e, 0x70, 0x72, 0x6f, 0x74, 0x6f, 0x62, 0x75, 0x66, 0x2e, 0x42, 0x6f,
+	0x6f, 0x6c, 0x56, 0x61, 0x6c, 0x75, 0x65, 0x52, 0x0e, 0x46, 0x69, 0x6c, 0x65, 0x53, 0x74, 0x72,
+	0x65, 0x61, 0x6d, 0x47, 0x7a, 0x69, 0x70, 0x12, 0x53, 0x0a, 0x17, 0x5f, 0x66, 0x69, 0x6c, 0x65,
+	0x5f, 0x73, 0x74, 0x72, 0x65, 0x61, 0x6d, 0x5f, 0x6d, 0x69, 0x72, 0x72, 0x6f, 0x72, 0x5f, 0x64,
+	0x69, 0x72, 0x18, 0xaa, 0x01, 0x20, 0x01, 0x28, 0x0b, 0x32, 0x1c, 0x2e, 0x67, 0x6f, 0x6f, 0x67,
+	0x6c, 0x65, 0x2e, 0x70, 0x72, 0x6f, 0x74, 0x6f, 0x62, 0x75, 0x66, 0x2e, 0x53, 0x74, 0x72, 0x69,
+	0x6e, 0x67, 0x56, 0x61, 0x6c, 0x75, 0x65, 0x52, 0x13, 0x46, 0x69, 0x6c, 0x65, 0x53, 0x74, 0x72,
+	0x65, 0x61, 0x6d, 0x4d, 0x69, 0x72, 0x72, 0x6f, 0x72, 0x44, 0x69, 0x72, 0x12, 0x53, 0x0a, 0x17,
+	0x5f, 0x66, 0x69, 0x6c, 0x65, 0x5f, 0x73, 0x74, 0x72, 0x65, 0x61, 0x6d, 0x5f, 0x6d, 0x69, 0x72,
+	0x72, 0x6f, 0x72, 0x5f, 0x75, 0x72, 0x6c, 0x18, 0xab, 0x01, 0x20, 0x01, 0x28, 0x0b, 0x32, 0x1c,
+	0x2e, 0x67, 0x6f, 0x6f, 0x67, 0x6c, 0x65, 0x2e, 0x70, 0x72, 0x6f, 0x74, 0x6f, 0x62, 0x75, 0x66,
+	0x2e, 0x53, 0x74, 0x72, 0x69, 0x6e, 0x67, 0x56, 0x61, 0x6c, 0x75, 0x65, 0x52, 0x13, 0x46, 0x69,
+	0x6c, 0x65, 0x53, 0x74, 0x72, 0x65, 0x61, 0x6d, 0x4d, 0x69, 0x72, 0x72, 0x6f, 0x72, 0x55, 0x72,
+	0x6c, 0x12, 0x5a, 0x0a, 0x1b, 0x5f, 0x66, 0x69, 0x6c, 0x65, 0x5f, 0x73, 0x74, 0x72, 0x65, 0x61,
+	0x6d, 0x5f, 0x6d, 0x69, 0x72, 0x72, 0x6f, 0x72, 0x5f, 0x61, 0x70, 0x69, 0x5f, 0x6b, 0x65, 0x79,
+	0x18, 0xac, 0x01, 0x20, 0x01, 0x28, 0x0b, 0x32, 0x1c, 0x2e, 0x67, 0x6f, 0x6f, 0x67, 0x6c, 0x65,
+	0x2e, 0x70, 0x72, 0x6f, 0x74, 0x6f, 0x62, 0x75, 0x66, 0x2e, 0x53, 0x74, 0x72, 0x69, 0x6e, 0x67,
+	0x56, 0x61, 0x6c, 0x75, 0x65, 0x52, 0x16, 0x46, 0x69, 0x6c, 0x65, 0x53, 0x74, 0x72, 0x65, 0x61,
+	0x6d, 0x4d, 0x69, 0x72, 0x72, 0x6f, 0x72, 0x41, 0x70, 0x69, 0x4b, 0x65, 0x79, 0x12, 0x50, 0x0a,
+	0x15, 0x5f, 0x73, 0x68, 0x61, 0x72, 0x65, 0x64, 0x5f, 0x68, 0x69, 0x73, 0x74, 0x6f, 0x72, 0x79,
+	0x5f, 0x6d, 0x65, 0x72, 0x67, 0x65, 0x18, 0xad, 0x01, 0x20, 0x01, 0x28, 0x0b, 0x32, 0x1c, 0x2e,
+	0x67, 0x6f, 0x6f, 0x67, 0x6c, 0x65, 0x2e, 0x70, 0x72, 0x6f, 0x74, 0x6f, 0x62, 0x75, 0x66, 0x2e,
+	0x53, 0x74, 0x72, 0x69, 0x6e, 0x67, 0x56, 0x61, 0x6c, 0x75, 0x65, 0x52, 0x12, 0x53, 0x68, 0x61,
+	0x72, 0x65, 0x64, 0x48, 0x69, 0x73, 0x74, 0x6f, 0x72, 0x79, 0x4d, 0x65, 0x72, 0x67, 0x65, 0x12,
+	0x43, 0x0a, 0x08, 0x5f, 0x70, 0x72, 0x6f, 0x78, 0x69, 0x65, 0x73, 0x18, 0xc8, 0x01, 0x20, 0x01,
+	0x28, 0x0b, 0x32, 0x27, 0x2e, 0x77, 0x61, 0x6e, 0x64, 0x62, 0x5f, 0x69, 0x6e, 0x74, 0x65, 0x72,
+	0x6e, 0x61, 0x6c, 0x2e, 0x4d, 0x61, 0x70, 0x53, 0x74, 0x72, 0x69, 0x6e, 0x67, 0x4b, 0x65, 0x79,
+	0x53, 0x74, 0x72, 0x69, 0x6e, 0x67, 0x56, 0x61, 0x6c, 0x75, 0x65, 0x52, 0x07, 0x50, 0x72, 0x6f,
+	0x78, 0x69, 0x65, 0x73, 0x62, 0x06, 0x70, 0x72, 0x6f, 0x74, 0x6f, 0x33,
 }
 
 var (
@@ -2638,13 +2743,21 @@ var file_wandb_proto_wandb_settings_proto_depIdxs = []int32{
 	10,  // 165: wandb_internal.Settings._stats_buffer_size:type_name -> google.protobuf.Int32Value
 	9,   // 166: wandb_internal.Settings._shared:type_name -> google.protobuf.BoolValue
 	8,   // 167: wandb_internal.Settings._code_path_local:type_name -> google.protobuf.StringValue
-	1,   // 168: wandb_internal.Settings._proxies:type_name -> wandb_internal.MapStringKeyStringValue
-	1,   // 169: wandb_internal.MapStringKeyMapStringKeyStringValue.ValueEntry.value:type_name -> wandb_internal.MapStringKeyStringValue
-	170, // [170:170] is the sub-list for method output_type
-	170, // [170:170] is the sub-list for method input_type
-	170, // [170:170] is the sub-list for extension type_name
-	170, // [170:170] is the sub-list for extension extendee
-	0,   // [0:170] is the sub-list for field type_name
+	10,  // 168: wandb_internal.Settings._sync_file_segment_mb:type_name -> google.protobuf.Int32Value
+	9,   // 169: wandb_internal.Settings._sync_file_compact:type_name -> google.protobuf.BoolValue
+	8,   // 170: wandb_internal.Settings._sync_file_compression:type_name -> google.protobuf.StringValue
+	9,   // 171: wandb_internal.Settings._file_stream_gzip:type_name -> google.protobuf.BoolValue
+	8,   // 172: wandb_internal.Settings._file_stream_mirror_dir:type_name -> google.protobuf.StringValue
+	8,   // 173: wandb_internal.Settings._file_stream_mirror_url:type_name -> google.protobuf.StringValue
+	8,   // 174: wandb_internal.Settings._file_stream_mirror_api_key:type_name -> google.protobuf.StringValue
+	8,   // 175: wandb_internal.Settings._shared_history_merge:type_name -> google.protobuf.StringValue
+	1,   // 176: wandb_internal.Settings._proxies:type_name -> wandb_internal.MapStringKeyStringValue
+	1,   // 177: wandb_internal.MapStringKeyMapStringKeyStringValue.ValueEntry.value:type_name -> wandb_internal.MapStringKeyStringValue
+	178, // [178:178] is the sub-list for method output_type
+	178, // [178:178] is the sub-list for method input_type
+	178, // [178:178] is the sub-list for extension type_name
+	178, // [178:178] is the sub-list for extension extendee
+	0,   // [0:178] is the sub-list for field type_name
 }
 
 func init() { file_wandb_proto_wandb_settings_proto_init() }
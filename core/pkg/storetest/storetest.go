@@ -0,0 +1,26 @@
+// Package storetest has helpers for tests that read transaction logs.
+package storetest
+
+import (
+	"context"
+	"os"
+	"testing"
+
+	"github.com/stretchr/testify/assert"
+
+	"github.com/wandb/wandb/core/pkg/observability"
+	"github.com/wandb/wandb/core/pkg/server"
+	"github.com/wandb/wandb/core/pkg/service"
+)
+
+// WriteLog writes a transaction log at path with the records, numbered
+// from 1 in order.
+func WriteLog(t *testing.T, path string, records ...*service.Record) {
+	store := server.NewStore(context.Background(), path, observability.NewNoOpLogger())
+	assert.NoError(t, store.Open(os.O_WRONLY))
+	for i, record := range records {
+		record.Num = int64(i + 1)
+		assert.NoError(t, store.Write(record))
+	}
+	assert.NoError(t, store.Close())
+}
@@ -2,6 +2,7 @@
 
 import json
 import os
+import struct
 
 import pytest
 import wandb
@@ -141,3 +142,54 @@ def test_data_write_fill(with_datastore):
         expected_records=records,
         expected_record_sizes=lengths,
     )
+
+
+def test_scan_compressed_version(with_datastore):
+    """Read an uncompressed record from a version 1 log."""
+    ds = with_datastore
+    ds._fp.seek(0)
+    ds._fp.write(
+        struct.pack(
+            "<4sHB",
+            b":W&B",
+            datastore.LEVELDBLOG_HEADER_MAGIC,
+            datastore.LEVELDBLOG_HEADER_VERSION_COMPRESSED,
+        )
+    )
+    ds._write_data(bytes([datastore.LEVELDBLOG_COMPRESSION_NONE]) + b"record")
+    ds.close()
+
+    ds = datastore.DataStore()
+    ds.open_for_scan(FNAME)
+    assert ds.scan_data() == b"record"
+    assert ds.scan_data() is None
+    ds.close()
+
+
+def test_scan_segmented_log(with_datastore):
+    """Read the records of all segments listed by the manifest."""
+    ds = with_datastore
+    ds._write_data(b"first")
+    ds.close()
+
+    second = datastore.segment_path(FNAME, 1)
+    ds = datastore.DataStore()
+    ds.open_for_write(second)
+    ds._write_data(b"second")
+    ds.close()
+
+    manifest = FNAME + datastore.LEVELDBLOG_MANIFEST_SUFFIX
+    with open(manifest, "w") as f:
+        json.dump({"segments": [{"offset": 0}, {"offset": 12}]}, f)
+
+    try:
+        ds = datastore.DataStore()
+        ds.open_for_scan(FNAME)
+        assert ds.scan_data() == b"first"
+        assert not ds.in_last_block()
+        assert ds.scan_data() == b"second"
+        assert ds.scan_data() is None
+        ds.close()
+    finally:
+        os.unlink(second)
+        os.unlink(manifest)
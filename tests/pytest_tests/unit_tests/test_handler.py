@@ -43,3 +43,34 @@ def test_handle_bigint(test_settings):
     assert sampled_history["ints"] == [1]
     assert len(sampled_history["floats"]) == 2
     assert len(sampled_history["bigint"]) == 0
+
+
+def test_sampled_history_range(test_settings):
+    result_q = queue.Queue()
+    settings = test_settings({})
+    hm = handler.HandleManager(
+        settings=settings_static.SettingsStatic(settings.to_proto()),
+        record_q=MagicMock(),
+        result_q=result_q,
+        stopped=MagicMock(),
+        writer_q=MagicMock(),
+        interface=MagicMock(),
+        context_keeper=MagicMock(),
+    )
+
+    request = pb.Request()
+    request.sampled_history.CopyFrom(pb.SampledHistoryRequest())
+    record = pb.Record()
+    record.request.CopyFrom(request)
+
+    hm._sampled_history = defaultdict(sample.UniformSampleAccumulator)
+    for value in [2.5, float("nan"), -1.25, 4.5, 0.5]:
+        hm._sampled_history["floats"].add(value)
+    hm.handle(record)
+    result = result_q.get()
+
+    (item,) = result.response.sampled_history_response.item
+    assert list(item.values_double)[0] == 2.5
+    assert item.min == -1.25
+    assert item.max == 4.5
+    assert item.last == 0.5
@@ -162,9 +162,17 @@ def test_metric_summary(mock_run, parse_records, record_q):
     )
     assert not metric_5.summary
 
+    metric_6 = run.define_metric(
+        "metric",
+        summary="first,last",
+    )
+    assert metric_6.summary == ("first", "last")
+
     parsed = parse_records(record_q)
-    assert len(parsed.records) == 5
-    assert len(parsed.metric) == 5
+    assert len(parsed.records) == 6
+    assert len(parsed.metric) == 6
+    assert parsed.metric[5].summary.first
+    assert parsed.metric[5].summary.last
 
 
 @pytest.mark.parametrize(
@@ -900,6 +900,7 @@ class MetricSummary(google.protobuf.message.Message):
     LAST_FIELD_NUMBER: builtins.int
     NONE_FIELD_NUMBER: builtins.int
     COPY_FIELD_NUMBER: builtins.int
+    FIRST_FIELD_NUMBER: builtins.int
     min: builtins.bool
     max: builtins.bool
     mean: builtins.bool
@@ -907,6 +908,7 @@ class MetricSummary(google.protobuf.message.Message):
     last: builtins.bool
     none: builtins.bool
     copy: builtins.bool
+    first: builtins.bool
     def __init__(
         self,
         *,
@@ -917,8 +919,9 @@ class MetricSummary(google.protobuf.message.Message):
         last: builtins.bool = ...,
         none: builtins.bool = ...,
         copy: builtins.bool = ...,
+        first: builtins.bool = ...,
     ) -> None: ...
-    def ClearField(self, field_name: typing_extensions.Literal["best", b"best", "copy", b"copy", "last", b"last", "max", b"max", "mean", b"mean", "min", b"min", "none", b"none"]) -> None: ...
+    def ClearField(self, field_name: typing_extensions.Literal["best", b"best", "copy", b"copy", "first", b"first", "last", b"last", "max", b"max", "mean", b"mean", "min", b"min", "none", b"none"]) -> None: ...
 
 global___MetricSummary = MetricSummary
 
@@ -2261,17 +2264,34 @@ class SyncOverwrite(google.protobuf.message.Message):
     RUN_ID_FIELD_NUMBER: builtins.int
     ENTITY_FIELD_NUMBER: builtins.int
     PROJECT_FIELD_NUMBER: builtins.int
+    GROUP_FIELD_NUMBER: builtins.int
+    JOB_TYPE_FIELD_NUMBER: builtins.int
+    TAGS_FIELD_NUMBER: builtins.int
+    NOTES_FIELD_NUMBER: builtins.int
+    DISPLAY_NAME_FIELD_NUMBER: builtins.int
     run_id: builtins.str
     entity: builtins.str
     project: builtins.str
+    group: builtins.str
+    job_type: builtins.str
+    @property
+    def tags(self) -> google.protobuf.internal.containers.RepeatedScalarFieldContainer[builtins.str]:
+        """replaces the tags of the run if not empty"""
+    notes: builtins.str
+    display_name: builtins.str
     def __init__(
         self,
         *,
         run_id: builtins.str = ...,
         entity: builtins.str = ...,
         project: builtins.str = ...,
+        group: builtins.str = ...,
+        job_type: builtins.str = ...,
+        tags: collections.abc.Iterable[builtins.str] | None = ...,
+        notes: builtins.str = ...,
+        display_name: builtins.str = ...,
     ) -> None: ...
-    def ClearField(self, field_name: typing_extensions.Literal["entity", b"entity", "project", b"project", "run_id", b"run_id"]) -> None: ...
+    def ClearField(self, field_name: typing_extensions.Literal["display_name", b"display_name", "entity", b"entity", "group", b"group", "job_type", b"job_type", "notes", b"notes", "project", b"project", "run_id", b"run_id", "tags", b"tags"]) -> None: ...
 
 global___SyncOverwrite = SyncOverwrite
 
@@ -2279,13 +2299,25 @@ class SyncSkip(google.protobuf.message.Message):
     DESCRIPTOR: google.protobuf.descriptor.Descriptor
 
     OUTPUT_RAW_FIELD_NUMBER: builtins.int
+    RECORD_TYPES_FIELD_NUMBER: builtins.int
+    HISTORY_KEYS_FIELD_NUMBER: builtins.int
     output_raw: builtins.bool
+    @property
+    def record_types(self) -> google.protobuf.internal.containers.RepeatedScalarFieldContainer[builtins.str]:
+        """record types to drop, named as in Record, e.g. "stats" or "tbrecord";
+        run and exit records are always kept
+        """
+    @property
+    def history_keys(self) -> google.protobuf.internal.containers.RepeatedScalarFieldContainer[builtins.str]:
+        """glob patterns of history keys to drop; keys starting with "_" are kept"""
     def __init__(
         self,
         *,
         output_raw: builtins.bool = ...,
+        record_types: collections.abc.Iterable[builtins.str] | None = ...,
+        history_keys: collections.abc.Iterable[builtins.str] | None = ...,
     ) -> None: ...
-    def ClearField(self, field_name: typing_extensions.Literal["output_raw", b"output_raw"]) -> None: ...
+    def ClearField(self, field_name: typing_extensions.Literal["history_keys", b"history_keys", "output_raw", b"output_raw", "record_types", b"record_types"]) -> None: ...
 
 global___SyncSkip = SyncSkip
 
@@ -2305,12 +2337,15 @@ class SyncRequest(google.protobuf.message.Message):
     FINAL_OFFSET_FIELD_NUMBER: builtins.int
     OVERWRITE_FIELD_NUMBER: builtins.int
     SKIP_FIELD_NUMBER: builtins.int
+    FOLLOW_FIELD_NUMBER: builtins.int
     start_offset: builtins.int
     final_offset: builtins.int
     @property
     def overwrite(self) -> global___SyncOverwrite: ...
     @property
     def skip(self) -> global___SyncSkip: ...
+    follow: builtins.bool
+    """keep reading the log as it is written until its exit record"""
     def __init__(
         self,
         *,
@@ -2318,9 +2353,10 @@ class SyncRequest(google.protobuf.message.Message):
         final_offset: builtins.int = ...,
         overwrite: global___SyncOverwrite | None = ...,
         skip: global___SyncSkip | None = ...,
+        follow: builtins.bool = ...,
     ) -> None: ...
     def HasField(self, field_name: typing_extensions.Literal["overwrite", b"overwrite", "skip", b"skip"]) -> builtins.bool: ...
-    def ClearField(self, field_name: typing_extensions.Literal["final_offset", b"final_offset", "overwrite", b"overwrite", "skip", b"skip", "start_offset", b"start_offset"]) -> None: ...
+    def ClearField(self, field_name: typing_extensions.Literal["final_offset", b"final_offset", "follow", b"follow", "overwrite", b"overwrite", "skip", b"skip", "start_offset", b"start_offset"]) -> None: ...
 
 global___SyncRequest = SyncRequest
 
@@ -2348,18 +2384,22 @@ class SenderReadRequest(google.protobuf.message.Message):
 
     START_OFFSET_FIELD_NUMBER: builtins.int
     FINAL_OFFSET_FIELD_NUMBER: builtins.int
+    FOLLOW_FIELD_NUMBER: builtins.int
     start_offset: builtins.int
     final_offset: builtins.int
     """TODO: implement cancel for paused ops
     repeated string cancel_list = 3;
     """
+    follow: builtins.bool
+    """keep reading the log as it is written until its exit record"""
     def __init__(
         self,
         *,
         start_offset: builtins.int = ...,
         final_offset: builtins.int = ...,
+        follow: builtins.bool = ...,
     ) -> None: ...
-    def ClearField(self, field_name: typing_extensions.Literal["final_offset", b"final_offset", "start_offset", b"start_offset"]) -> None: ...
+    def ClearField(self, field_name: typing_extensions.Literal["final_offset", b"final_offset", "follow", b"follow", "start_offset", b"start_offset"]) -> None: ...
 
 global___SenderReadRequest = SenderReadRequest
 
@@ -2846,6 +2886,10 @@ class SampledHistoryItem(google.protobuf.message.Message):
     NESTED_KEY_FIELD_NUMBER: builtins.int
     VALUES_FLOAT_FIELD_NUMBER: builtins.int
     VALUES_INT_FIELD_NUMBER: builtins.int
+    VALUES_DOUBLE_FIELD_NUMBER: builtins.int
+    MIN_FIELD_NUMBER: builtins.int
+    MAX_FIELD_NUMBER: builtins.int
+    LAST_FIELD_NUMBER: builtins.int
     key: builtins.str
     @property
     def nested_key(self) -> google.protobuf.internal.containers.RepeatedScalarFieldContainer[builtins.str]: ...
@@ -2853,6 +2897,13 @@ class SampledHistoryItem(google.protobuf.message.Message):
     def values_float(self) -> google.protobuf.internal.containers.RepeatedScalarFieldContainer[builtins.float]: ...
     @property
     def values_int(self) -> google.protobuf.internal.containers.RepeatedScalarFieldContainer[builtins.int]: ...
+    @property
+    def values_double(self) -> google.protobuf.internal.containers.RepeatedScalarFieldContainer[builtins.float]:
+        """the samples of values_float at full precision"""
+    min: builtins.float
+    """the range and last value of all values, not only the samples"""
+    max: builtins.float
+    last: builtins.float
     def __init__(
         self,
         *,
@@ -2860,8 +2911,12 @@ class SampledHistoryItem(google.protobuf.message.Message):
         nested_key: collections.abc.Iterable[builtins.str] | None = ...,
         values_float: collections.abc.Iterable[builtins.float] | None = ...,
         values_int: collections.abc.Iterable[builtins.int] | None = ...,
+        values_double: collections.abc.Iterable[builtins.float] | None = ...,
+        min: builtins.float = ...,
+        max: builtins.float = ...,
+        last: builtins.float = ...,
     ) -> None: ...
-    def ClearField(self, field_name: typing_extensions.Literal["key", b"key", "nested_key", b"nested_key", "values_float", b"values_float", "values_int", b"values_int"]) -> None: ...
+    def ClearField(self, field_name: typing_extensions.Literal["key", b"key", "last", b"last", "max", b"max", "min", b"min", "nested_key", b"nested_key", "values_double", b"values_double", "values_float", b"values_float", "values_int", b"values_int"]) -> None: ...
 
 global___SampledHistoryItem = SampledHistoryItem
 
@@ -14,10 +14,20 @@ header :=
   ident: char[4]
   magic: uint16
   version: uint8
+
+In a version 1 log, the data of every record starts with a byte naming its
+compression: 0 for none, 1 for zstd and 2 for snappy. Reading compressed
+records requires the zstandard or python-snappy package.
+
+A log can be split into segments, listed by a JSON manifest named after the
+log with the suffix ".manifest". The first segment is the file named after the
+log, segment i > 0 is the file with the suffix ".i", and each segment starts
+with its own header. Logs without a manifest have a single segment.
 """
 
 # TODO: possibly restructure code by porting the C++ or go implementation
 
+import json
 import logging
 import os
 import struct
@@ -47,6 +57,13 @@ LEVELDBLOG_HEADER_MAGIC = (
     0xBEE1  # zlib.crc32(bytes("Weights & Biases", 'iso8859-1')) & 0xffff
 )
 LEVELDBLOG_HEADER_VERSION = 0
+LEVELDBLOG_HEADER_VERSION_COMPRESSED = 1
+
+LEVELDBLOG_COMPRESSION_NONE = 0
+LEVELDBLOG_COMPRESSION_ZSTD = 1
+LEVELDBLOG_COMPRESSION_SNAPPY = 2
+
+LEVELDBLOG_MANIFEST_SUFFIX = ".manifest"
 
 try:
     bytes("", "ascii")
@@ -63,6 +80,26 @@ except Exception:
     # bytestostr = str
 
 
+def segment_path(fname: str, i: int) -> str:
+    """Return the path of the i-th segment of a log."""
+    if i == 0:
+        return fname
+    return f"{fname}.{i}"
+
+
+def read_segment_count(fname: str) -> int:
+    """Return the number of segments of a log, as listed by its manifest."""
+    path = fname + LEVELDBLOG_MANIFEST_SUFFIX
+    if not os.path.exists(path):
+        return 1
+    with open(path) as f:
+        manifest = json.load(f)
+    segments = manifest.get("segments")
+    if not segments:
+        raise Exception(f"Manifest of {fname} has no segments")
+    return len(segments)
+
+
 class DataStore:
     _index: int
     _flush_offset: int
@@ -73,6 +110,9 @@ class DataStore:
         self._index = 0
         self._flush_offset = 0
         self._size_bytes = 0
+        self._version = LEVELDBLOG_HEADER_VERSION
+        self._segment = 0
+        self._segment_count = 1
 
         self._crc = [0] * (LEVELDBLOG_LAST + 1)
         for x in range(1, LEVELDBLOG_LAST + 1):
@@ -98,10 +138,23 @@ class DataStore:
 
     def open_for_scan(self, fname):
         self._fname = fname
-        logger.info("open for scan: %s", fname)
-        self._fp = open(fname, "r+b")
+        self._segment_count = read_segment_count(fname)
+        self._open_segment_for_scan(0)
+
+    def _open_segment_for_scan(self, i: int) -> None:
+        """Open the i-th segment of the log for scanning.
+
+        Offsets, as used by seek and get_offset, are positions in the open
+        segment.
+        """
+        if self._fp is not None:
+            self._fp.close()
+        path = segment_path(self._fname, i)
+        logger.info("open for scan: %s", path)
+        self._fp = open(path, "r+b")
+        self._segment = i
         self._index = 0
-        self._size_bytes = os.stat(fname).st_size
+        self._size_bytes = os.stat(path).st_size
         self._opened_for_scan = True
         self._read_header()
 
@@ -115,6 +168,8 @@ class DataStore:
 
     def in_last_block(self):
         """Determine if we're in the last block to handle in-progress writes."""
+        if self._segment + 1 < self._segment_count:
+            return False
         return self._index > self._size_bytes - LEVELDBLOG_DATA_LEN
 
     def scan_record(self):
@@ -142,6 +197,35 @@ class DataStore:
         return dtype, data
 
     def scan_data(self):
+        data = self._scan_raw_data()
+        while data is None and self._segment + 1 < self._segment_count:
+            # continue with the next segment of the log
+            self._open_segment_for_scan(self._segment + 1)
+            data = self._scan_raw_data()
+        if data is None or self._version != LEVELDBLOG_HEADER_VERSION_COMPRESSED:
+            return data
+        return self._decompress(data)
+
+    def _decompress(self, data):
+        assert data, "record is missing its compression byte"
+        compression, data = data[0], data[1:]
+        if compression == LEVELDBLOG_COMPRESSION_NONE:
+            return data
+        if compression == LEVELDBLOG_COMPRESSION_ZSTD:
+            try:
+                import zstandard
+            except ImportError:
+                raise Exception("Reading zstd records requires zstandard")
+            return zstandard.ZstdDecompressor().decompress(data)
+        if compression == LEVELDBLOG_COMPRESSION_SNAPPY:
+            try:
+                import snappy
+            except ImportError:
+                raise Exception("Reading snappy records requires python-snappy")
+            return snappy.uncompress(data)
+        raise Exception(f"Unknown record compression {compression}")
+
+    def _scan_raw_data(self):
         # TODO(jhr): handle some assertions as file corruption issues
         # how much left in the block.  if less than header len, read as pad,
         offset = self._index % LEVELDBLOG_BLOCK_LEN
@@ -203,8 +287,12 @@ class DataStore:
             raise Exception("Invalid header")
         if magic != LEVELDBLOG_HEADER_MAGIC:
             raise Exception("Invalid header")
-        if version != LEVELDBLOG_HEADER_VERSION:
+        if version not in (
+            LEVELDBLOG_HEADER_VERSION,
+            LEVELDBLOG_HEADER_VERSION_COMPRESSED,
+        ):
             raise Exception("Invalid header")
+        self._version = version
         self._index += len(header)
 
     def _write_record(self, s, dtype=None):
@@ -841,6 +841,12 @@ class HandleManager:
                     pass
             elif all(isinstance(i, numbers.Real) for i in values):
                 item.values_float.extend(values)
+                item.values_double.extend(values)
+            if sampled.last is not None:
+                item.last = float(sampled.last)
+            if sampled.min is not None:
+                item.min = float(sampled.min)
+                item.max = float(sampled.max)
             result.response.sampled_history_response.item.append(item)
         self._respond_result(result)
 
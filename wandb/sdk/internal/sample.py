@@ -23,6 +23,12 @@ class UniformSampleAccumulator:
         self._count = 0
         self._log2 = [0]
 
+        # the range and last value of all values, not only the samples;
+        # NaN is left out of the range
+        self.min = None
+        self.max = None
+        self.last = None
+
         # pre-allocate buckets
         for _ in range(self._buckets):
             self._bucket.append([0] * self._max)
@@ -37,6 +43,13 @@ class UniformSampleAccumulator:
             print(f"{b}: {vals}")
 
     def add(self, val):
+        self.last = val
+        if not math.isnan(val):
+            if self.min is None or val < self.min:
+                self.min = val
+            if self.max is None or val > self.max:
+                self.max = val
+
         self._count += 1
         cnt = self._count
         if cnt & self._mask:
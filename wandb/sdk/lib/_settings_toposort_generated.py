@@ -28,6 +28,10 @@ _Setting = Literal[
     "_except_exit",
     "_executable",
     "_extra_http_headers",
+    "_file_stream_gzip",
+    "_file_stream_mirror_api_key",
+    "_file_stream_mirror_dir",
+    "_file_stream_mirror_url",
     "_file_stream_retry_max",
     "_file_stream_retry_wait_min_seconds",
     "_file_stream_retry_wait_max_seconds",
@@ -58,6 +62,9 @@ _Setting = Literal[
     "_notebook",
     "_offline",
     "_sync",
+    "_sync_file_compact",
+    "_sync_file_compression",
+    "_sync_file_segment_mb",
     "_os",
     "_platform",
     "_proxies",
@@ -68,6 +75,7 @@ _Setting = Literal[
     "_service_transport",
     "_service_wait",
     "_shared",
+    "_shared_history_merge",
     "_start_datetime",
     "_start_time",
     "_stats_pid",
@@ -44,10 +44,15 @@ _name_to_level = {
 
 
 class _Printer:
-    def sparklines(self, series: List[Union[int, float]]) -> Optional[str]:
+    def sparklines(
+        self,
+        series: List[Union[int, float]],
+        minimum: Optional[float] = None,
+        maximum: Optional[float] = None,
+    ) -> Optional[str]:
         # Only print sparklines if the terminal is utf-8
         if wandb.util.is_unicode_safe(sys.stdout):
-            return sparkline.sparkify(series)
+            return sparkline.sparkify(series, minimum, maximum)
         return None
 
     def abort(
@@ -2,7 +2,7 @@
 # From pysparklines (BSD License): https://pypi.python.org/pypi/pysparklines
 
 import math
-from typing import List, Union
+from typing import List, Optional, Union
 
 spark_chars = "▁▂▃▄▅▆▇█"
 
@@ -12,9 +12,17 @@ def isfinite(f):
     return not (math.isinf(f) or math.isnan(f))
 
 
-def sparkify(series: List[Union[float, int]]) -> str:
+def sparkify(
+    series: List[Union[float, int]],
+    minimum: Optional[float] = None,
+    maximum: Optional[float] = None,
+) -> str:
     """Convert <series> to a sparkline string.
 
+    The heights are relative to <minimum> and <maximum> if they are given
+    and the series is within them, such as when the series is a sample of
+    values whose range is known, and to the range of the series otherwise.
+
     Example:
     >>> sparkify([ 0.5, 1.2, 3.5, 7.3, 8.0, 12.5, 13.2, 15.0, 14.2, 11.8, 6.1,
     ... 1.9 ])
@@ -30,8 +38,16 @@ def sparkify(series: List[Union[float, int]]) -> str:
     finite_series = [x for x in series if isfinite(x)]
     if not finite_series:
         return ""
-    minimum = min(finite_series)
-    maximum = max(finite_series)
+    if (
+        minimum is None
+        or maximum is None
+        or not isfinite(minimum)
+        or not isfinite(maximum)
+        or minimum > min(finite_series)
+        or maximum < max(finite_series)
+    ):
+        minimum = min(finite_series)
+        maximum = max(finite_series)
     data_range = maximum - minimum
     if data_range == 0.0:
         # Graph a baseline if every input value is equal.
@@ -92,6 +92,8 @@ class Metric:
                 m.summary.max = True
             if "mean" in summary_set:
                 m.summary.mean = True
+            if "first" in summary_set:
+                m.summary.first = True
             if "last" in summary_set:
                 m.summary.last = True
             if "copy" in summary_set:
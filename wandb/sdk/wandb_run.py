@@ -2645,7 +2645,7 @@ class Run:
                 Defaults to True if step_metric is specified.
             hidden: Hide this metric from automatic plots.
             summary: Specify aggregate metrics added to summary.
-                Supported aggregations: "min,max,mean,best,last,none"
+                Supported aggregations: "min,max,mean,best,first,last,none"
                 Default aggregation is `copy`
                 Aggregation `best` defaults to `goal`==`minimize`
             goal: Specify direction for optimizing the metric.
@@ -2700,7 +2700,7 @@ class Run:
         if summary:
             summary_items = [s.lower() for s in summary.split(",")]
             summary_ops = []
-            valid = {"min", "max", "mean", "best", "last", "copy", "none"}
+            valid = {"min", "max", "mean", "best", "first", "last", "copy", "none"}
             for i in summary_items:
                 if i not in valid:
                     raise wandb.Error(f"Unhandled define_metric() arg: summary op: {i}")
@@ -3937,19 +3937,25 @@ class Run:
         if history:
             logger.info("rendering history")
 
+            # values_double has the samples of values_float at full precision;
+            # min, max and last are of all the values, not only the samples
             sampled_history = {
-                item.key: wandb.util.downsample(
-                    item.values_float or item.values_int, 40
-                )
+                item.key: item
                 for item in history.item
                 if not item.key.startswith("_")
             }
 
             history_rows = []
-            for key, values in sorted(sampled_history.items()):
+            for key, item in sorted(sampled_history.items()):
+                values = wandb.util.downsample(
+                    item.values_double or item.values_float or item.values_int, 40
+                )
                 if any(not isinstance(value, numbers.Number) for value in values):
                     continue
-                sparkline = printer.sparklines(values)
+                # the sparkline ends at the last value even if it was not sampled
+                if values and values[-1] != item.last:
+                    values[-1] = item.last
+                sparkline = printer.sparklines(values, item.min, item.max)
                 if sparkline:
                     history_rows.append([key, sparkline])
             if history_rows:
@@ -316,6 +316,10 @@ class SettingsData:
     _except_exit: bool
     _executable: str
     _extra_http_headers: Mapping[str, str]
+    _file_stream_gzip: bool  # compress filestream requests
+    _file_stream_mirror_api_key: str  # API key for _file_stream_mirror_url
+    _file_stream_mirror_dir: str  # keep a copy of the streamed files here
+    _file_stream_mirror_url: str  # also stream the files to this server
     # file stream retry client configuration
     _file_stream_retry_max: int  # max number of retries
     _file_stream_retry_wait_min_seconds: float  # min wait time between retries
@@ -349,6 +353,9 @@ class SettingsData:
     _notebook: bool
     _offline: bool
     _sync: bool
+    _sync_file_compact: bool  # compact the transaction log once the run finishes
+    _sync_file_compression: str  # compress transaction log records: zstd or snappy
+    _sync_file_segment_mb: int  # split the transaction log into segments of this many MB
     _os: str
     _platform: str
     _proxies: Mapping[str, str]  # dedicated global proxy servers [scheme -> url]
@@ -359,6 +366,7 @@ class SettingsData:
     _service_transport: str
     _service_wait: float
     _shared: bool
+    _shared_history_merge: str  # how shared run history is merged: server_step, step or timestamp
     _start_datetime: str
     _start_time: float
     _stats_pid: int  # (internal) base pid for system stats
@@ -660,6 +668,7 @@ class Settings(SettingsData):
             _disable_update_check={"preprocessor": _str_as_bool},
             _disable_viewer={"preprocessor": _str_as_bool},
             _extra_http_headers={"preprocessor": _str_as_json},
+            _file_stream_gzip={"value": False, "preprocessor": _str_as_bool},
             # Retry filestream requests for 2 hours before dropping chunk (how do we recover?)
             # retry_count = seconds_in_2_hours / max_retry_time + num_retries_until_max_60_sec
             #             = 7200 / 60 + ceil(log2(60/2))
@@ -729,6 +738,9 @@ class Settings(SettingsData):
                 "hook": lambda _: self.mode == "shared",
                 "auto_hook": True,
             },
+            _shared_history_merge={
+                "validator": self._validate__shared_history_merge,
+            },
             _start_datetime={"preprocessor": _datetime_as_str},
             _stats_sample_rate_seconds={
                 "value": 2.0,
@@ -761,6 +773,11 @@ class Settings(SettingsData):
                 "preprocessor": int,
             },
             _sync={"value": False},
+            _sync_file_compact={"value": False, "preprocessor": _str_as_bool},
+            _sync_file_compression={
+                "validator": self._validate__sync_file_compression,
+            },
+            _sync_file_segment_mb={"preprocessor": int},
             _tmp_code_dir={
                 "value": "code",
                 "hook": lambda x: self._path_convert(self.tmp_dir, x),
@@ -1179,6 +1196,23 @@ class Settings(SettingsData):
             raise UsageError("_stats_samples_to_average must be between 1 and 30")
         return True
 
+    @staticmethod
+    def _validate__shared_history_merge(value: str) -> bool:
+        if value not in ("server_step", "step", "timestamp"):
+            raise UsageError(
+                "_shared_history_merge must be one of "
+                "'server_step', 'step' or 'timestamp'"
+            )
+        return True
+
+    @staticmethod
+    def _validate__sync_file_compression(value: str) -> bool:
+        if value not in ("none", "zstd", "snappy"):
+            raise UsageError(
+                "_sync_file_compression must be one of 'none', 'zstd' or 'snappy'"
+            )
+        return True
+
     @staticmethod
     def _validate__async_upload_concurrency_limit(value: int) -> bool:
         if value <= 0: